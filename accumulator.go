@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import "math/big"
+
+// BigAccumulator holds a running BigFloat value at a fixed working precision
+// and records whether any operation applied to it had to round.
+// This lets a pipeline assert that a whole sequence of operations was computed
+// exactly at the working precision.
+type BigAccumulator struct {
+	value   *BigFloat
+	inexact bool
+}
+
+// NewBigAccumulator creates an accumulator initialized to x with the given precision
+// If x cannot be represented exactly at prec, the accumulator starts out inexact
+func NewBigAccumulator(x *BigFloat, prec uint) *BigAccumulator {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+	a := &BigAccumulator{value: new(BigFloat).SetPrec(prec)}
+	a.value.Set(x)
+	a.record()
+	return a
+}
+
+// record notes whether the last operation on the value was inexact
+func (a *BigAccumulator) record() {
+	if a.value.Acc() != big.Exact {
+		a.inexact = true
+	}
+}
+
+// Add sets the accumulated value to value + x and returns the accumulator
+func (a *BigAccumulator) Add(x *BigFloat) *BigAccumulator {
+	a.value.Add(a.value, x)
+	a.record()
+	return a
+}
+
+// Sub sets the accumulated value to value - x and returns the accumulator
+func (a *BigAccumulator) Sub(x *BigFloat) *BigAccumulator {
+	a.value.Sub(a.value, x)
+	a.record()
+	return a
+}
+
+// Mul sets the accumulated value to value * x and returns the accumulator
+func (a *BigAccumulator) Mul(x *BigFloat) *BigAccumulator {
+	a.value.Mul(a.value, x)
+	a.record()
+	return a
+}
+
+// Quo sets the accumulated value to value / x and returns the accumulator
+// Like big.Float, it panics with big.ErrNaN for 0/0 and ∞/∞
+func (a *BigAccumulator) Quo(x *BigFloat) *BigAccumulator {
+	a.value.Quo(a.value, x)
+	a.record()
+	return a
+}
+
+// Exact reports whether every operation so far was computed without rounding
+func (a *BigAccumulator) Exact() bool {
+	return !a.inexact
+}
+
+// Result returns a copy of the accumulated value
+func (a *BigAccumulator) Result() *BigFloat {
+	return new(BigFloat).SetPrec(a.value.Prec()).Set(a.value)
+}
+
+// Prec returns the working precision of the accumulator
+func (a *BigAccumulator) Prec() uint {
+	return a.value.Prec()
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"testing"
+)
+
+func TestBigAccumulatorExact(t *testing.T) {
+	prec := uint(128)
+
+	acc := NewBigAccumulator(NewBigFloat(7.0, prec), prec)
+	acc.Add(NewBigFloat(5.0, prec)).
+		Mul(NewBigFloat(3.0, prec)).
+		Sub(NewBigFloat(6.0, prec)).
+		Quo(NewBigFloat(4.0, prec))
+
+	if !acc.Exact() {
+		t.Error("integer arithmetic should be exact")
+	}
+	// ((7 + 5) * 3 - 6) / 4 = 7.5
+	if acc.Result().Cmp(NewBigFloat(7.5, prec)) != 0 {
+		t.Errorf("Result() = %s, want 7.5", acc.Result().Text('g', 20))
+	}
+	if acc.Prec() != prec {
+		t.Errorf("Prec() = %d, want %d", acc.Prec(), prec)
+	}
+}
+
+func TestBigAccumulatorInexact(t *testing.T) {
+	prec := uint(128)
+
+	acc := NewBigAccumulator(NewBigFloat(1.0, prec), prec)
+	acc.Quo(NewBigFloat(3.0, prec))
+	if acc.Exact() {
+		t.Error("1/3 should be inexact")
+	}
+
+	// Direct computation gives the same value
+	direct := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1.0, prec), NewBigFloat(3.0, prec))
+	if acc.Result().Cmp(direct) != 0 {
+		t.Errorf("Result() = %s, want %s", acc.Result().Text('g', 40), direct.Text('g', 40))
+	}
+
+	// Inexactness is sticky, even after exact operations
+	acc.Mul(NewBigFloat(2.0, prec))
+	if acc.Exact() {
+		t.Error("Exact() should stay false after an inexact operation")
+	}
+}
+
+func TestBigAccumulatorInitialRounding(t *testing.T) {
+	x := new(BigFloat).SetPrec(256).Quo(NewBigFloat(1.0, 256), NewBigFloat(3.0, 256))
+	acc := NewBigAccumulator(x, 64)
+	if acc.Exact() {
+		t.Error("rounding the initial value to lower precision should be inexact")
+	}
+
+	// Addition that overflows the working precision rounds
+	acc = NewBigAccumulator(NewBigFloat(1.0, 8), 8)
+	acc.Add(new(BigFloat).SetMantExp(NewBigFloat(1.0, 64), -20))
+	if acc.Exact() {
+		t.Error("1 + 2^-20 at 8 bits should be inexact")
+	}
+}
+
+func TestBigAccumulatorResultIsCopy(t *testing.T) {
+	acc := NewBigAccumulator(NewBigFloat(2.0, 0), 0)
+	r := acc.Result()
+	r.SetInt64(100)
+	if acc.Result().Cmp(NewBigFloat(2.0, 0)) != 0 {
+		t.Error("modifying Result() changed the accumulator")
+	}
+	if acc.Prec() != DefaultPrecision {
+		t.Errorf("Prec() = %d, want %d", acc.Prec(), DefaultPrecision)
+	}
+}
@@ -59,3 +59,101 @@ func RadNorm02PiBig(rad *BigFloat, prec uint) *BigFloat {
 
 	return result
 }
+
+// BigWrapToPi reduces the angle x (radians) to the range [-π, π]
+// The reduction modulo 2π is carried out with enough extra bits to cover the
+// integer part of x/2π, so the result is accurate to prec bits however large
+// x is. x must be finite.
+func BigWrapToPi(x *BigFloat, prec uint) *BigFloat {
+	return normalizeAngle(x, prec)
+}
+
+// BigWrapTo2Pi reduces the angle x (radians) to the range [0, 2π)
+// The reduction is that of BigWrapToPi. A tiny negative remainder that would
+// round up to 2π is returned as 0. x must be finite.
+func BigWrapTo2Pi(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	workPrec := prec + 32
+
+	r := normalizeAngle(x, workPrec)
+	if r.Sign() < 0 {
+		r.Add(r, BigTwoPI(workPrec))
+	}
+	result := new(BigFloat).SetPrec(prec).Set(r)
+	if result.Cmp(BigTwoPI(prec)) >= 0 {
+		return NewBigFloat(0.0, prec)
+	}
+	return result
+}
+
+// BigRadians converts an angle in degrees to radians: rad = deg·π/180
+func BigRadians(deg *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = deg.Prec()
+	}
+	workPrec := prec + 32
+	result := new(BigFloat).SetPrec(workPrec).Mul(deg, BigPI(workPrec))
+	result.Quo(result, NewBigFloat(180.0, workPrec))
+	return new(BigFloat).SetPrec(prec).Set(result)
+}
+
+// BigDegrees converts an angle in radians to degrees: deg = rad·180/π
+func BigDegrees(rad *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = rad.Prec()
+	}
+	workPrec := prec + 32
+	result := new(BigFloat).SetPrec(workPrec).Mul(rad, NewBigFloat(180.0, workPrec))
+	result.Quo(result, BigPI(workPrec))
+	return new(BigFloat).SetPrec(prec).Set(result)
+}
+
+// BigHaversine computes the haversine hav(θ) = sin²(θ/2)
+func BigHaversine(theta *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = theta.Prec()
+	}
+	workPrec := prec + 32
+	half := new(BigFloat).SetPrec(workPrec).SetMantExp(theta, -1)
+	s := BigSin(half, workPrec)
+	s.Mul(s, s)
+	return new(BigFloat).SetPrec(prec).Set(s)
+}
+
+// BigAngularSeparation returns the great-circle angle in radians between two
+// points given by right ascension and declination in radians
+// It uses the Vincenty form
+//
+//	θ = atan2(√((cos δ2 sin Δα)² + (cos δ1 sin δ2 - sin δ1 cos δ2 cos Δα)²),
+//	          sin δ1 sin δ2 + cos δ1 cos δ2 cos Δα)
+//
+// which stays accurate for both tiny and near-antipodal separations, where
+// acos of the dot product loses about half the significant digits.
+func BigAngularSeparation(ra1, dec1, ra2, dec2 *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = ra1.Prec()
+	}
+	workPrec := prec + 32
+
+	dRA := new(BigFloat).SetPrec(workPrec).Sub(ra2, ra1)
+	sinDRA, cosDRA := BigSinCos(dRA, workPrec)
+	sinD1, cosD1 := BigSinCos(dec1, workPrec)
+	sinD2, cosD2 := BigSinCos(dec2, workPrec)
+
+	mul := func(a, b *BigFloat) *BigFloat { return new(BigFloat).SetPrec(workPrec).Mul(a, b) }
+
+	// num = √(a² + b²)
+	a := mul(cosD2, sinDRA)
+	b := mul(cosD1, sinD2)
+	b.Sub(b, mul(mul(sinD1, cosD2), cosDRA))
+	num := mul(a, a)
+	num.Add(num, mul(b, b))
+	num.Sqrt(num)
+
+	den := mul(sinD1, sinD2)
+	den.Add(den, mul(mul(cosD1, cosD2), cosDRA))
+
+	return BigAtan2(num, den, prec)
+}
@@ -209,3 +209,223 @@ func TestAngleConversionEquivalence(t *testing.T) {
 		})
 	}
 }
+
+// TestBigRadiansDegrees tests degree/radian conversion and the round trip
+func TestBigRadiansDegrees(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-45", prec)
+
+	t.Run("180_is_pi", func(t *testing.T) {
+		if got := BigRadians(NewBigFloat(180, prec), prec); !BigFloatEqual(got, BigPI(prec), tol) {
+			t.Errorf("BigRadians(180) = %s, want π", got.Text('g', 50))
+		}
+		if got := BigDegrees(BigPI(prec), prec); !BigFloatEqual(got, NewBigFloat(180, prec), tol) {
+			t.Errorf("BigDegrees(π) = %s, want 180", got.Text('g', 50))
+		}
+	})
+
+	t.Run("90_is_half_pi", func(t *testing.T) {
+		if got := BigRadians(NewBigFloat(90, prec), prec); !BigFloatEqual(got, BigHalfPI(prec), tol) {
+			t.Errorf("BigRadians(90) = %s, want π/2", got.Text('g', 50))
+		}
+	})
+
+	t.Run("round_trip", func(t *testing.T) {
+		for _, deg := range []float64{0, 1, -45.5, 359.999, 23.4392911, 1e6} {
+			x := NewBigFloat(deg, prec)
+			back := BigDegrees(BigRadians(x, prec), prec)
+			if !BigFloatEqual(back, x, tol) {
+				t.Errorf("BigDegrees(BigRadians(%g)) = %s", deg, back.Text('g', 50))
+			}
+		}
+	})
+
+	t.Run("float64_agreement", func(t *testing.T) {
+		got, _ := BigRadians(NewBigFloat(30, prec), prec).Float64()
+		if math.Abs(got-math.Pi/6) > 1e-15 {
+			t.Errorf("BigRadians(30) = %v, want %v", got, math.Pi/6)
+		}
+	})
+}
+
+// TestBigHaversine tests sin²(θ/2) against (1 - cos θ)/2
+func TestBigHaversine(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	for _, v := range []float64{0, 0.1, 1, math.Pi / 2, 3, -2} {
+		theta := NewBigFloat(v, prec)
+		want := BigCos(theta, prec+32)
+		want.Sub(NewBigFloat(1, prec+32), want)
+		want.Quo(want, NewBigFloat(2, prec+32))
+		if got := BigHaversine(theta, prec); !BigFloatEqual(got, want, tol) {
+			t.Errorf("BigHaversine(%g) = %s, want %s", v, got.Text('g', 40), want.Text('g', 40))
+		}
+	}
+
+	if got := BigHaversine(BigPI(prec), prec); !BigFloatEqual(got, NewBigFloat(1, prec), tol) {
+		t.Errorf("BigHaversine(π) = %s, want 1", got.Text('g', 40))
+	}
+}
+
+// TestBigAngularSeparation tests great-circle separations, including a
+// 1 arcsecond separation where the acos formula loses precision
+func TestBigAngularSeparation(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+	arcsec := BigRadians(new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1, prec), NewBigFloat(3600, prec)), prec)
+	dec := BigRadians(NewBigFloat(20, prec), prec)
+	zero := NewBigFloat(0, prec)
+
+	t.Run("one_arcsecond_in_dec", func(t *testing.T) {
+		dec2 := new(BigFloat).SetPrec(prec).Add(dec, arcsec)
+		ra := BigRadians(NewBigFloat(150, prec), prec)
+		got := BigAngularSeparation(ra, dec, ra, dec2, prec)
+		if !BigFloatEqual(got, arcsec, tol) {
+			t.Errorf("separation = %s, want 1\" = %s", got.Text('g', 50), arcsec.Text('g', 50))
+		}
+
+		// The float64 acos formula is already wrong in the sixth digit
+		d, _ := dec.Float64()
+		d2, _ := dec2.Float64()
+		naive := math.Acos(math.Sin(d)*math.Sin(d2) + math.Cos(d)*math.Cos(d2))
+		want, _ := arcsec.Float64()
+		if math.Abs(naive/want-1) < 1e-7 {
+			t.Errorf("float64 acos separation = %v, expected it to lose precision against %v", naive, want)
+		}
+	})
+
+	t.Run("one_arcsecond_on_equator", func(t *testing.T) {
+		got := BigAngularSeparation(zero, zero, arcsec, zero, prec)
+		if !BigFloatEqual(got, arcsec, tol) {
+			t.Errorf("separation = %s, want %s", got.Text('g', 50), arcsec.Text('g', 50))
+		}
+	})
+
+	t.Run("pole_to_equator", func(t *testing.T) {
+		got := BigAngularSeparation(NewBigFloat(1.234, prec), BigHalfPI(prec), zero, zero, prec)
+		if !BigFloatEqual(got, BigHalfPI(prec), tol) {
+			t.Errorf("separation = %s, want π/2", got.Text('g', 50))
+		}
+	})
+
+	t.Run("antipodal", func(t *testing.T) {
+		negDec := new(BigFloat).Neg(dec)
+		got := BigAngularSeparation(zero, dec, BigPI(prec), negDec, prec)
+		if !BigFloatEqual(got, BigPI(prec), tol) {
+			t.Errorf("separation = %s, want π", got.Text('g', 50))
+		}
+	})
+
+	t.Run("symmetric_and_haversine", func(t *testing.T) {
+		ra1, dec1 := NewBigFloat(0.3, prec), NewBigFloat(-0.7, prec)
+		ra2, dec2 := NewBigFloat(2.1, prec), NewBigFloat(0.4, prec)
+		ab := BigAngularSeparation(ra1, dec1, ra2, dec2, prec)
+		ba := BigAngularSeparation(ra2, dec2, ra1, dec1, prec)
+		if !BigFloatEqual(ab, ba, tol) {
+			t.Errorf("separation not symmetric: %s vs %s", ab.Text('g', 40), ba.Text('g', 40))
+		}
+
+		// hav θ = hav Δδ + cos δ1 cos δ2 hav Δα
+		dDec := new(BigFloat).Sub(dec2, dec1)
+		dRA := new(BigFloat).Sub(ra2, ra1)
+		want := new(BigFloat).SetPrec(prec).Mul(BigCos(dec1, prec), BigCos(dec2, prec))
+		want.Mul(want, BigHaversine(dRA, prec))
+		want.Add(want, BigHaversine(dDec, prec))
+		if got := BigHaversine(ab, prec); !BigFloatEqual(got, want, tol) {
+			t.Errorf("hav(θ) = %s, want %s", got.Text('g', 40), want.Text('g', 40))
+		}
+	})
+}
+
+func TestBigWrapAngle(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-70", prec)
+
+	// reference reduces x with 2048-bit π: x - floor(x/2π + shift)·2π
+	reference := func(x *BigFloat, shift float64) *BigFloat {
+		const refPrec = 2048
+		twoPi := BigTwoPI(refPrec)
+		n := new(BigFloat).SetPrec(refPrec).Quo(x, twoPi)
+		n = BigFloor(n.Add(n, NewBigFloat(shift, refPrec)), refPrec)
+		r := new(BigFloat).SetPrec(refPrec).Set(x)
+		return r.Sub(r, n.Mul(n, twoPi))
+	}
+
+	pi := BigPI(prec)
+	twoPi := BigTwoPI(prec)
+	negPi := new(BigFloat).Neg(pi)
+
+	inputs := []string{"3", "-2.5", "7", "1e6", "-1e6", "123456789.123456789", "1e22", "-7.5e15", "1e40"}
+	for _, s := range inputs {
+		x, _ := NewBigFloatFromString(s, prec)
+
+		t.Run("pi/"+s, func(t *testing.T) {
+			got := BigWrapToPi(x, prec)
+			if got.Cmp(negPi) < 0 || got.Cmp(pi) > 0 {
+				t.Fatalf("BigWrapToPi(%s) = %s, outside [-π, π]", s, got.Text('g', 20))
+			}
+			if want := reference(x, 0.5); !BigFloatEqual(got, want, tol) {
+				t.Errorf("BigWrapToPi(%s) = %s, want %s", s, got.Text('g', 40), want.Text('g', 40))
+			}
+		})
+
+		t.Run("2pi/"+s, func(t *testing.T) {
+			got := BigWrapTo2Pi(x, prec)
+			if got.Sign() < 0 || got.Cmp(twoPi) >= 0 {
+				t.Fatalf("BigWrapTo2Pi(%s) = %s, outside [0, 2π)", s, got.Text('g', 20))
+			}
+			if want := reference(x, 0); !BigFloatEqual(got, want, tol) {
+				t.Errorf("BigWrapTo2Pi(%s) = %s, want %s", s, got.Text('g', 40), want.Text('g', 40))
+			}
+		})
+	}
+
+	t.Run("float64_reduction_fails", func(t *testing.T) {
+		// 1e22 is exact in float64, but reducing it with float64 2π gives a
+		// different angle entirely
+		x := NewBigFloat(1e22, prec)
+		naive := math.Remainder(1e22, 2*math.Pi)
+		got, _ := BigWrapToPi(x, prec).Float64()
+		if math.Abs(got-naive) < 0.1 {
+			t.Errorf("float64 reduction %v unexpectedly close to %v", naive, got)
+		}
+
+		want, _ := NewBigFloatFromString("-0.8522008497671888017727058937530293682618", prec)
+		sinTol, _ := NewBigFloatFromString("1e-38", prec)
+		if s := BigSin(BigWrapToPi(x, prec), prec); !BigFloatEqual(s, want, sinTol) {
+			t.Errorf("sin(wrap(1e22)) = %s, want %s", s.Text('g', 40), want.Text('g', 40))
+		}
+	})
+
+	t.Run("tiny_negative", func(t *testing.T) {
+		x, _ := NewBigFloatFromString("-1e-100", prec)
+		if got := BigWrapTo2Pi(x, prec); got.Sign() != 0 {
+			t.Errorf("BigWrapTo2Pi(-1e-100) = %s, want 0", got.Text('g', 20))
+		}
+		if got := BigWrapToPi(x, prec); got.Cmp(x) != 0 {
+			t.Errorf("BigWrapToPi(-1e-100) = %s, want -1e-100", got.Text('g', 20))
+		}
+	})
+}
+
+func TestBigWrapAngleSharesCachedPi(t *testing.T) {
+	prec := uint(256)
+	count := func() int {
+		piCache.mu.RLock()
+		defer piCache.mu.RUnlock()
+		return len(piCache.values)
+	}
+
+	// 1.5·2^e for 181 different exponents asks for π at 181 different raw
+	// precisions, which share a few 64-bit buckets
+	before := count()
+	for e := 20; e <= 200; e++ {
+		x := new(BigFloat).SetMantExp(NewBigFloat(1.5, prec), e)
+		BigWrapToPi(x, prec)
+		BigSin(x, prec)
+	}
+	if grown := count() - before; grown > 8 {
+		t.Errorf("π cache grew by %d entries over 181 argument exponents", grown)
+	}
+}
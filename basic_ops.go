@@ -33,3 +33,72 @@ func BigMod(x, y *BigFloat, prec uint) *BigFloat {
 func BigRem(x, y *BigFloat, prec uint) *BigFloat {
 	return getDispatcher().BigRemImpl(x, y, prec)
 }
+
+// BigIsInteger reports whether x has no fractional part.
+// The check uses the binary exponent and the minimum precision needed to
+// represent the mantissa, so it is exact for values far beyond float64 range.
+// ±Inf is not an integer.
+func BigIsInteger(x *BigFloat) bool {
+	if x.IsInf() {
+		return false
+	}
+	if x.Sign() == 0 {
+		return true
+	}
+	// x = 0.mant × 2^exp with MinPrec significant bits; it is an integer
+	// when all significant bits lie at or above the binary point
+	exp := x.MantExp(nil)
+	return exp >= int(x.MinPrec())
+}
+
+// BigFractionalPart returns x - trunc(x), the fractional part of x.
+// The result has the same sign as x and is computed exactly before
+// rounding to prec. Returns 0 for ±Inf.
+func BigFractionalPart(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	if x.IsInf() || BigIsInteger(x) {
+		return NewBigFloat(0.0, prec)
+	}
+
+	// x - trunc(x) only drops high-order bits, so x's precision is enough
+	// to hold the difference exactly
+	workPrec := x.Prec()
+	intPart := BigTrunc(x, workPrec)
+	frac := new(BigFloat).SetPrec(workPrec).Sub(x, intPart)
+
+	return new(BigFloat).SetPrec(prec).Set(frac)
+}
+
+// BigFrexp breaks x into a fraction and a power of two such that
+// x = frac × 2^exp with |frac| in [0.5, 1). The fraction has the sign of x
+// and is rounded to prec. Zero returns (±0, 0) and ±Inf returns (±Inf, 0),
+// matching math.Frexp.
+func BigFrexp(x *BigFloat, prec uint) (frac *BigFloat, exp int) {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	frac = new(BigFloat)
+	exp = x.MantExp(frac)
+	frac.SetPrec(prec)
+
+	// Rounding a mantissa just below 1 to fewer bits can carry it up to 1
+	if frac.IsInt() && frac.Sign() != 0 {
+		frac.SetMantExp(frac, -1)
+		exp++
+	}
+	return frac, exp
+}
+
+// BigLdexp is the inverse of BigFrexp and returns frac × 2^exp rounded to
+// prec. Zero and ±Inf are returned unchanged, matching math.Ldexp.
+func BigLdexp(frac *BigFloat, exp int, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = frac.Prec()
+	}
+
+	return new(BigFloat).SetPrec(prec).SetMantExp(frac, exp)
+}
@@ -385,3 +385,138 @@ func TestBigRem(t *testing.T) {
 		}
 	})
 }
+
+func TestBigIsInteger(t *testing.T) {
+	prec := uint(256)
+
+	huge, _ := NewBigFloatFromString("1e100", prec)
+	hugePlusHalf := new(BigFloat).SetPrec(1024).Add(huge, NewBigFloat(0.5, prec))
+	tiny := new(BigFloat).SetPrec(prec).SetMantExp(NewBigFloat(1.0, prec), -300)
+
+	tests := []struct {
+		name     string
+		x        *BigFloat
+		expected bool
+	}{
+		{"integer", NewBigFloat(5.0, prec), true},
+		{"fraction", NewBigFloat(5.5, prec), false},
+		{"negative_integer", NewBigFloat(-7.0, prec), true},
+		{"negative_fraction", NewBigFloat(-7.25, prec), false},
+		{"zero", NewBigFloat(0.0, prec), true},
+		{"one_e100", huge, true},
+		{"one_e100_plus_half", hugePlusHalf, false},
+		{"tiny", tiny, false},
+		{"positive_infinity", new(BigFloat).SetInf(false), false},
+		{"negative_infinity", new(BigFloat).SetInf(true), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BigIsInteger(tt.x); got != tt.expected {
+				t.Errorf("BigIsInteger(%s) = %v, want %v", tt.x.Text('g', 20), got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBigFractionalPart(t *testing.T) {
+	prec := uint(256)
+
+	tests := []struct {
+		name     string
+		input    float64
+		expected float64
+	}{
+		{"positive", 5.25, 0.25},
+		{"negative", -5.25, -0.25},
+		{"integer", 5.0, 0.0},
+		{"less_than_one", 0.75, 0.75},
+		{"negative_less_than_one", -0.75, -0.75},
+		{"zero", 0.0, 0.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := BigFractionalPart(NewBigFloat(tt.input, prec), prec)
+			if result.Cmp(NewBigFloat(tt.expected, prec)) != 0 {
+				t.Errorf("BigFractionalPart(%g) = %s, want %g", tt.input, result.Text('g', 20), tt.expected)
+			}
+			if result.Prec() != prec {
+				t.Errorf("BigFractionalPart precision = %d, want %d", result.Prec(), prec)
+			}
+		})
+	}
+
+	t.Run("beyond_float64", func(t *testing.T) {
+		huge, _ := NewBigFloatFromString("1e100", 1024)
+		x := new(BigFloat).SetPrec(1024).Add(huge, NewBigFloat(0.125, prec))
+		result := BigFractionalPart(x, prec)
+		if result.Cmp(NewBigFloat(0.125, prec)) != 0 {
+			t.Errorf("BigFractionalPart(1e100+0.125) = %s, want 0.125", result.Text('g', 20))
+		}
+	})
+
+	t.Run("infinity", func(t *testing.T) {
+		for _, sign := range []bool{false, true} {
+			result := BigFractionalPart(new(BigFloat).SetInf(sign), prec)
+			if result.Sign() != 0 || result.IsInf() {
+				t.Errorf("BigFractionalPart(Inf) = %s, want 0", result.Text('g', 10))
+			}
+		}
+	})
+}
+
+func TestBigFrexpLdexp(t *testing.T) {
+	prec := uint(256)
+	half := NewBigFloat(0.5, prec)
+	one := NewBigFloat(1.0, prec)
+
+	t.Run("round_trip", func(t *testing.T) {
+		for _, s := range []string{"1", "-1", "0.5", "3", "-0.75", "1e-300", "-1e-300", "1e300", "123456789.123456789", "-2.718281828459045235360287471352662497757", "1e-100000", "1e100000"} {
+			x, _ := NewBigFloatFromString(s, prec)
+			frac, exp := BigFrexp(x, prec)
+
+			absFrac := new(BigFloat).Abs(frac)
+			if absFrac.Cmp(half) < 0 || absFrac.Cmp(one) >= 0 {
+				t.Errorf("BigFrexp(%s) frac = %s, want |frac| in [0.5, 1)", s, frac.Text('g', 20))
+			}
+			if frac.Sign() != x.Sign() {
+				t.Errorf("BigFrexp(%s) frac sign = %d, want %d", s, frac.Sign(), x.Sign())
+			}
+			if got := BigLdexp(frac, exp, prec); got.Cmp(x) != 0 {
+				t.Errorf("BigLdexp(BigFrexp(%s)) = %s", s, got.Text('g', 40))
+			}
+		}
+	})
+
+	t.Run("matches_math", func(t *testing.T) {
+		for _, f := range []float64{1, 8, -0.3, 1e-310, math.MaxFloat64} {
+			frac, exp := BigFrexp(NewBigFloat(f, prec), prec)
+			wantFrac, wantExp := math.Frexp(f)
+			if got, _ := frac.Float64(); got != wantFrac || exp != wantExp {
+				t.Errorf("BigFrexp(%g) = (%g, %d), want (%g, %d)", f, got, exp, wantFrac, wantExp)
+			}
+		}
+	})
+
+	t.Run("rounding_carry", func(t *testing.T) {
+		// 1 - 2^-100 rounds up to 1 at 53 bits, so the fraction renormalizes to 0.5
+		x := new(BigFloat).SetPrec(prec).Sub(one, new(BigFloat).SetMantExp(one, -100))
+		frac, exp := BigFrexp(x, 53)
+		if frac.Cmp(half) != 0 || exp != 1 {
+			t.Errorf("BigFrexp(1-2^-100, 53) = (%s, %d), want (0.5, 1)", frac.Text('g', 20), exp)
+		}
+	})
+
+	t.Run("special_values", func(t *testing.T) {
+		for _, x := range []*BigFloat{NewBigFloat(0.0, prec), new(BigFloat).SetInf(false), new(BigFloat).SetInf(true)} {
+			frac, exp := BigFrexp(x, prec)
+			if exp != 0 || frac.Cmp(x) != 0 {
+				t.Errorf("BigFrexp(%s) = (%s, %d), want (%s, 0)", x.Text('g', 10), frac.Text('g', 10), exp, x.Text('g', 10))
+			}
+			if got := BigLdexp(x, 10, prec); got.Cmp(x) != 0 {
+				t.Errorf("BigLdexp(%s, 10) = %s", x.Text('g', 10), got.Text('g', 10))
+			}
+		}
+	})
+}
@@ -284,6 +284,7 @@ func BenchmarkBigMatMulMat_Generic(b *testing.B) {
 			{NewBigFloat(0.0, 256), NewBigFloat(0.0, 256), NewBigFloat(1.0, 256)},
 		},
 	}
+	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		bigMatMulMatGeneric(m1, m2, 256)
 	}
@@ -304,11 +305,32 @@ func BenchmarkBigMatMulMat_Optimized(b *testing.B) {
 			{NewBigFloat(0.0, 256), NewBigFloat(0.0, 256), NewBigFloat(1.0, 256)},
 		},
 	}
+	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		bigMatMulMatOptimized(m1, m2, 256)
 	}
 }
 
+// BenchmarkBigSinCos_Generic reports the allocations of the Taylor series
+// loops, whose temporaries come from the scratch pool
+func BenchmarkBigSinCos_Generic(b *testing.B) {
+	x := NewBigFloat(1.2345, 256)
+
+	b.Run("sin", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bigSinGeneric(x, 256)
+		}
+	})
+
+	b.Run("cos", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bigCosGeneric(x, 256)
+		}
+	})
+}
+
 func BenchmarkBigMatDet_Generic(b *testing.B) {
 	m := &BigMatrix3x3{
 		M: [3][3]*BigFloat{
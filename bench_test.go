@@ -4,7 +4,9 @@
 package bigmath
 
 import (
+	"fmt"
 	"math"
+	"runtime"
 	"testing"
 )
 
@@ -28,6 +30,27 @@ func BenchmarkBigCos(b *testing.B) {
 	}
 }
 
+// BenchmarkBigSinCos compares BigSinCos against separate BigSin and BigCos calls
+func BenchmarkBigSinCos(b *testing.B) {
+	prec := uint(benchPrec)
+	x := NewBigFloat(1.2345, prec)
+
+	b.Run("BigSinCos", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = BigSinCos(x, prec)
+		}
+	})
+
+	b.Run("BigSin_BigCos", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = BigSin(x, prec)
+			_ = BigCos(x, prec)
+		}
+	})
+}
+
 // BenchmarkBigExp benchmarks the BigExp function
 // Note: Skipped due to assembly/Go interop issues with stack maps
 // func BenchmarkBigExp(b *testing.B) {
@@ -58,6 +81,46 @@ func BenchmarkBigPow(b *testing.B) {
 	}
 }
 
+// BenchmarkBigPowIntegerExponent compares the integer exponent fast path
+// against the exp/log path taken by a nearby fractional exponent
+func BenchmarkBigPowIntegerExponent(b *testing.B) {
+	x := NewBigFloat(1.0001, benchPrec)
+
+	b.Run("integer", func(b *testing.B) {
+		y := NewBigFloat(30.0, benchPrec)
+		for i := 0; i < b.N; i++ {
+			_ = BigPow(x, y, benchPrec)
+		}
+	})
+
+	b.Run("fractional", func(b *testing.B) {
+		y := NewBigFloat(30.5, benchPrec)
+		for i := 0; i < b.N; i++ {
+			_ = BigPow(x, y, benchPrec)
+		}
+	})
+}
+
+// BenchmarkBigPowInt compares BigPowInt against BigPow with the same exponent
+func BenchmarkBigPowInt(b *testing.B) {
+	x := NewBigFloat(1.0001, benchPrec)
+
+	b.Run("BigPowInt", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = BigPowInt(x, 30, benchPrec)
+		}
+	})
+
+	b.Run("BigPow", func(b *testing.B) {
+		b.ReportAllocs()
+		y := NewBigFloat(30.0, benchPrec)
+		for i := 0; i < b.N; i++ {
+			_ = BigPow(x, y, benchPrec)
+		}
+	})
+}
+
 // BenchmarkBigSqrt benchmarks the BigSqrt function
 func BenchmarkBigSqrt(b *testing.B) {
 	x := NewBigFloat(2.0, benchPrec)
@@ -77,6 +140,31 @@ func BenchmarkBigVec3Add(b *testing.B) {
 	}
 }
 
+// BenchmarkBigVec3InPlace compares the allocating vector operations with the
+// in-place variants used to accumulate in integrators
+func BenchmarkBigVec3InPlace(b *testing.B) {
+	step := NewBigVec3(0.125, -2.5, 3.75, benchPrec)
+	scale := NewBigFloat(-1.0, benchPrec)
+
+	b.Run("Allocating", func(b *testing.B) {
+		acc := NewBigVec3(1.0, 2.0, 3.0, benchPrec)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			acc = BigVec3Add(acc, step, benchPrec)
+			acc = BigVec3Mul(acc, scale, benchPrec)
+		}
+	})
+
+	b.Run("InPlace", func(b *testing.B) {
+		acc := NewBigVec3(1.0, 2.0, 3.0, benchPrec)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			acc.AddInPlace(step, benchPrec)
+			acc.MulScalarInPlace(scale, benchPrec)
+		}
+	})
+}
+
 // BenchmarkBigVec3Dot benchmarks vector dot product
 func BenchmarkBigVec3Dot(b *testing.B) {
 	v1 := NewBigVec3(1.0, 2.0, 3.0, benchPrec)
@@ -317,3 +405,22 @@ func BenchmarkBigMatInverse(b *testing.B) {
 		_, _ = BigMatInverse(m, benchPrec)
 	}
 }
+
+// BenchmarkBigMatrixNMul compares serial and row-band parallel products
+func BenchmarkBigMatrixNMul(b *testing.B) {
+	for _, n := range []int{16, 64, 128} {
+		m := testMatrixN(n, 1.5, benchPrec)
+		other := testMatrixN(n, -0.25, benchPrec)
+
+		b.Run(fmt.Sprintf("n=%d/serial", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = m.mul(other, benchPrec, 1)
+			}
+		})
+		b.Run(fmt.Sprintf("n=%d/parallel", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = m.mul(other, benchPrec, runtime.GOMAXPROCS(0))
+			}
+		})
+	}
+}
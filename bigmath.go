@@ -6,8 +6,11 @@
 package bigmath
 
 import (
+	"fmt"
 	"math"
 	"math/big"
+	"strings"
+	"unicode"
 )
 
 // Default precision: 256 bits (77 decimal digits) - eliminates all rounding errors
@@ -72,6 +75,100 @@ func NewBigFloatFromString(s string, prec uint) (*BigFloat, error) {
 	return bf, nil
 }
 
+// NewBigFloatFromStringLocalized creates a BigFloat from a string that may
+// group integer digits with sep, e.g. "1,234,567.89" with sep ','
+// Separators are only accepted between well-formed groups of three digits in
+// the integer part, so a decimal comma such as "1,5" or "1,2,3" is rejected
+// rather than read as grouping. Strings without sep parse as in
+// NewBigFloatFromString.
+func NewBigFloatFromStringLocalized(s string, sep rune, prec uint) (*BigFloat, error) {
+	if sep == '.' || sep == '+' || sep == '-' || sep == 'e' || sep == 'E' || unicode.IsDigit(sep) || unicode.IsSpace(sep) {
+		return nil, fmt.Errorf("invalid grouping separator %q", sep)
+	}
+	if !strings.ContainsRune(s, sep) {
+		return NewBigFloatFromString(s, prec)
+	}
+
+	sign, body := "", s
+	if strings.HasPrefix(body, "+") || strings.HasPrefix(body, "-") {
+		sign, body = body[:1], body[1:]
+	}
+	intPart, rest := body, ""
+	if end := strings.IndexAny(body, ".eE"); end >= 0 {
+		intPart, rest = body[:end], body[end:]
+	}
+	if strings.ContainsRune(rest, sep) {
+		return nil, fmt.Errorf("invalid number %q: separator %q outside the integer part", s, sep)
+	}
+
+	groups := strings.Split(intPart, string(sep))
+	for i, g := range groups {
+		if len(g) == 0 || len(g) > 3 || (i > 0 && len(g) != 3) || strings.IndexFunc(g, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+			return nil, fmt.Errorf("invalid number %q: misplaced grouping separator %q", s, sep)
+		}
+	}
+
+	bf, err := NewBigFloatFromString(sign+strings.Join(groups, "")+rest, prec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+	return bf, nil
+}
+
+// NewBigFloatFromFraction creates a BigFloat from a fraction string such as "355/113"
+// Numerator and denominator are parsed as integers and divided exactly, so the
+// result is correctly rounded to prec.
+func NewBigFloatFromFraction(s string, prec uint) (*BigFloat, error) {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid fraction %q: expected exactly one '/'", s)
+	}
+	num, ok := new(big.Int).SetString(strings.TrimSpace(parts[0]), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid fraction %q: bad numerator %q", s, parts[0])
+	}
+	den, ok := new(big.Int).SetString(strings.TrimSpace(parts[1]), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid fraction %q: bad denominator %q", s, parts[1])
+	}
+	if den.Sign() == 0 {
+		return nil, fmt.Errorf("invalid fraction %q: zero denominator", s)
+	}
+	return NewBigFloatFromRat(new(big.Rat).SetFrac(num, den), prec), nil
+}
+
+// NewBigFloatFromBigInt creates a BigFloat from a big.Int with specified precision
+// The value is exact if it fits in prec bits, and correctly rounded otherwise.
+func NewBigFloatFromBigInt(i *big.Int, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+	return new(BigFloat).SetPrec(prec).SetInt(i)
+}
+
+// NewBigFloatFromRat creates a BigFloat from a big.Rat with specified precision
+// The quotient is correctly rounded to prec, with no float64 intermediate.
+func NewBigFloatFromRat(r *big.Rat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+	return new(BigFloat).SetPrec(prec).SetRat(r)
+}
+
+// BigFloatToRat returns the exact rational value of x
+// Every finite binary float is rational, so the conversion is exact. Returns
+// false for ±Inf.
+func BigFloatToRat(x *BigFloat) (*big.Rat, bool) {
+	if x.IsInf() {
+		return nil, false
+	}
+	r, _ := x.Rat(nil)
+	return r, true
+}
+
 // NewBigVec3 creates a new BigVec3 from float64 values
 func NewBigVec3(x, y, z float64, prec uint) *BigVec3 {
 	return &BigVec3{
@@ -124,6 +221,26 @@ func (v *BigVec3) ToFloat64() [3]float64 {
 	return [3]float64{x, y, z}
 }
 
+// BigFloatToFloat32 rounds x to the nearest float32
+// The accuracy reports whether the result is exact, or below or above x.
+// Values outside the float32 range become ±Inf or ±0.
+func BigFloatToFloat32(x *BigFloat) (float32, big.Accuracy) {
+	return x.Float32()
+}
+
+// ToFloat32 converts BigVec3 to float32 array
+// The flag is true when every component converted exactly.
+func (v *BigVec3) ToFloat32() ([3]float32, bool) {
+	var out [3]float32
+	exact := true
+	for i, c := range []*BigFloat{v.X, v.Y, v.Z} {
+		var acc big.Accuracy
+		out[i], acc = BigFloatToFloat32(c)
+		exact = exact && acc == big.Exact
+	}
+	return out, exact
+}
+
 // ToFloat64 converts BigVec6 to float64 array
 func (v *BigVec6) ToFloat64() [6]float64 {
 	x, _ := v.X.Float64()
@@ -150,6 +267,52 @@ func BigVec3Mul(v *BigVec3, scalar *BigFloat, prec uint) *BigVec3 {
 	return getDispatcher().BigVec3MulImpl(v, scalar, prec)
 }
 
+// inPlaceScratch prepares the pooled temporary t to compute a new value for
+// the component z: it takes z's precision (prec if z has none) and rounding
+// mode. Computing into t instead of z avoids the fresh mantissa math/big
+// allocates when the destination aliases an operand.
+func inPlaceScratch(t, z *BigFloat, prec uint) *BigFloat {
+	if z.Prec() != 0 {
+		prec = z.Prec()
+	}
+	return t.SetMode(z.Mode()).SetPrec(prec)
+}
+
+// AddInPlace sets v = v + other without allocating a new vector
+// Each component is rounded to its own precision and rounding mode; prec is
+// used only for components whose precision is 0.
+func (v *BigVec3) AddInPlace(other *BigVec3, prec uint) {
+	t := getScratch(0)
+	defer putScratch(t)
+	v.X.Set(inPlaceScratch(t, v.X, prec).Add(v.X, other.X))
+	v.Y.Set(inPlaceScratch(t, v.Y, prec).Add(v.Y, other.Y))
+	v.Z.Set(inPlaceScratch(t, v.Z, prec).Add(v.Z, other.Z))
+}
+
+// SubInPlace sets v = v - other without allocating a new vector
+// Precision is handled as in AddInPlace.
+func (v *BigVec3) SubInPlace(other *BigVec3, prec uint) {
+	t := getScratch(0)
+	defer putScratch(t)
+	v.X.Set(inPlaceScratch(t, v.X, prec).Sub(v.X, other.X))
+	v.Y.Set(inPlaceScratch(t, v.Y, prec).Sub(v.Y, other.Y))
+	v.Z.Set(inPlaceScratch(t, v.Z, prec).Sub(v.Z, other.Z))
+}
+
+// MulScalarInPlace sets v = v * scalar without allocating a new vector
+// Precision is handled as in AddInPlace.
+func (v *BigVec3) MulScalarInPlace(scalar *BigFloat, prec uint) {
+	// A scalar that is one of v's components would change part way through
+	if scalar == v.X || scalar == v.Y || scalar == v.Z {
+		scalar = new(BigFloat).Set(scalar)
+	}
+	t := getScratch(0)
+	defer putScratch(t)
+	v.X.Set(inPlaceScratch(t, v.X, prec).Mul(v.X, scalar))
+	v.Y.Set(inPlaceScratch(t, v.Y, prec).Mul(v.Y, scalar))
+	v.Z.Set(inPlaceScratch(t, v.Z, prec).Mul(v.Z, scalar))
+}
+
 // Dot computes the dot product of two BigVec3 vectors
 func BigVec3Dot(v1, v2 *BigVec3, prec uint) *BigFloat {
 	return getDispatcher().BigVec3DotImpl(v1, v2, prec)
@@ -223,33 +386,79 @@ func BigMin(a, b *BigFloat, prec uint) *BigFloat {
 	return new(BigFloat).SetPrec(prec).Set(b)
 }
 
-// Constants with high precision
-var (
-	bigPI     *BigFloat
-	bigTwoPI  *BigFloat
-	bigHalfPI *BigFloat
-)
+// BigClamp returns x limited to the range [lo, hi]: lo if x < lo, hi if x > hi,
+// and a copy of x otherwise. If lo > hi the range is empty and lo is returned.
+func BigClamp(x, lo, hi *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	switch {
+	case lo.Cmp(hi) > 0, x.Cmp(lo) < 0:
+		return new(BigFloat).SetPrec(prec).Set(lo)
+	case x.Cmp(hi) > 0:
+		return new(BigFloat).SetPrec(prec).Set(hi)
+	}
+	return new(BigFloat).SetPrec(prec).Set(x)
+}
 
-func init() {
-	// Initialize constants with maximum precision
-	prec := uint(DefaultPrecision)
+// BigFloatEqual reports whether |a - b| <= tol
+// The difference is computed in BigFloat with 32 guard bits beyond the
+// larger input precision, so values that agree to more than float64
+// precision can still be told apart. Infinities are equal only to an
+// infinity of the same sign.
+func BigFloatEqual(a, b, tol *BigFloat) bool {
+	if a.IsInf() || b.IsInf() {
+		return a.IsInf() && b.IsInf() && a.Signbit() == b.Signbit()
+	}
 
-	// Compute Pi using Chudnovsky algorithm (MPFR standard)
-	// 1/π = 12 * Σ ((-1)^k * (6k)! * (13591409 + 545140134k)) / ((3k)! * (k!)^3 * 640320^(3k+3/2))
-	// This converges at ~14 digits per term. For 256 bits (~77 digits), we need ~6 terms.
-	// We'll use a few more to be safe and generic for higher precision.
+	prec := a.Prec()
+	if b.Prec() > prec {
+		prec = b.Prec()
+	}
+	diff := new(BigFloat).SetPrec(prec+32).Sub(a, b)
+	return diff.Abs(diff).Cmp(tol) <= 0
+}
 
-	bigPI = computePiChudnovsky(prec)
+// BigVec3Equal reports whether every component of a and b agrees within tol
+// using BigFloatEqual
+func BigVec3Equal(a, b *BigVec3, tol *BigFloat) bool {
+	return BigFloatEqual(a.X, b.X, tol) && BigFloatEqual(a.Y, b.Y, tol) && BigFloatEqual(a.Z, b.Z, tol)
+}
 
-	// 2π
-	bigTwoPI = new(BigFloat).SetPrec(prec)
-	bigTwoPI.Mul(bigPI, NewBigFloat(2.0, prec))
+// BigCmpFloat64 compares x with the exact value of f and returns
+// -1 if x < f, 0 if x == f, +1 if x > f.
+// Unlike comparing the result of x.Float64(), no precision of x is lost.
+// NaN is converted like NewBigFloat does (to zero).
+func BigCmpFloat64(x *BigFloat, f float64) int {
+	// Every float64 is exactly representable with 53 bits
+	prec := x.Prec()
+	if prec < 53 {
+		prec = 53
+	}
+	return x.Cmp(NewBigFloat(f, prec))
+}
 
-	// π/2
-	bigHalfPI = new(BigFloat).SetPrec(prec)
-	bigHalfPI.Quo(bigPI, NewBigFloat(2.0, prec))
+// BigEqualFloat64 reports whether x is exactly equal to f.
+// Unlike comparing the result of x.Float64(), a value that differs from f
+// beyond float64 precision is not equal. NaN is never equal to anything.
+func BigEqualFloat64(x *BigFloat, f float64) bool {
+	if math.IsNaN(f) {
+		return false
+	}
+	return BigCmpFloat64(x, f) == 0
 }
 
+// Constants with high precision, computed on first use at each requested precision
+var (
+	piCache      = newConstantCache(computePiChudnovsky)
+	eCache       = newConstantCache(computeE)
+	ln2Cache     = newConstantCache(computeLn2)
+	ln10Cache    = newConstantCache(computeLn10)
+	sqrt2Cache   = newConstantCache(computeSqrt2)
+	phiCache     = newConstantCache(computePhi)
+	catalanCache = newConstantCache(computeCatalan)
+)
+
 // computePiChudnovsky computes Pi using the Chudnovsky algorithm
 // This is the algorithm used by MPFR and most high-precision libraries.
 func computePiChudnovsky(prec uint) *BigFloat {
@@ -383,31 +592,62 @@ func computePiChudnovsky(prec uint) *BigFloat {
 	return new(BigFloat).SetPrec(prec).Set(pi)
 }
 
+// atanhInverse computes atanh(1/n) = Σ 1/((2k+1)·n^(2k+1)) for an integer n > 1
+func atanhInverse(n int64, prec uint) *BigFloat {
+	workPrec := prec + 32
+
+	nBig := NewBigFloat(float64(n), workPrec)
+	n2 := new(BigFloat).SetPrec(workPrec).Mul(nBig, nBig)
+	power := new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(1.0, workPrec), nBig) // 1/n^(2k+1)
+	sum := new(BigFloat).SetPrec(workPrec)
+	term := new(BigFloat).SetPrec(workPrec)
+
+	for k := int64(0); power.MantExp(nil) > -int(workPrec); k++ {
+		term.Quo(power, NewBigFloat(float64(2*k+1), workPrec))
+		sum.Add(sum, term)
+		power.Quo(power, n2)
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(sum)
+}
+
+// computeLn2 computes ln(2) = 2·atanh(1/3)
+func computeLn2(prec uint) *BigFloat {
+	ln2 := atanhInverse(3, prec+8)
+	ln2.Mul(ln2, NewBigFloat(2.0, prec+8))
+	return new(BigFloat).SetPrec(prec).Set(ln2)
+}
+
+// computeLn10 computes ln(10) = 3·ln(2) + ln(5/4) = 3·ln(2) + 2·atanh(1/9)
+func computeLn10(prec uint) *BigFloat {
+	workPrec := prec + 8
+	ln10 := new(BigFloat).SetPrec(workPrec).Mul(computeLn2(workPrec), NewBigFloat(3.0, workPrec))
+	ln54 := atanhInverse(9, workPrec)
+	ln54.Mul(ln54, NewBigFloat(2.0, workPrec))
+	ln10.Add(ln10, ln54)
+	return new(BigFloat).SetPrec(prec).Set(ln10)
+}
+
 // BigPI returns π with specified precision
+// π is computed with the Chudnovsky algorithm once per precision and cached.
 func BigPI(prec uint) *BigFloat {
-	if prec == 0 {
-		prec = DefaultPrecision
-	}
-	return new(BigFloat).SetPrec(prec).Set(bigPI)
+	return piCache.get(prec)
 }
 
 // BigTwoPI returns 2π with specified precision
 func BigTwoPI(prec uint) *BigFloat {
-	if prec == 0 {
-		prec = DefaultPrecision
-	}
-	return new(BigFloat).SetPrec(prec).Set(bigTwoPI)
+	pi := piCache.get(prec)
+	return pi.SetMantExp(pi, 1)
 }
 
 // BigHalfPI returns π/2 with specified precision
 func BigHalfPI(prec uint) *BigFloat {
-	if prec == 0 {
-		prec = DefaultPrecision
-	}
-	return new(BigFloat).SetPrec(prec).Set(bigHalfPI)
+	pi := piCache.get(prec)
+	return pi.SetMantExp(pi, -1)
 }
 
-// BigSqrt computes the square root using Newton-Raphson method
+// BigSqrt computes the correctly rounded square root of x
+// Returns NaN (zero) for negative x.
 func BigSqrt(x *BigFloat, prec uint) *BigFloat {
 	if prec == 0 {
 		prec = x.Prec()
@@ -422,42 +662,9 @@ func BigSqrt(x *BigFloat, prec uint) *BigFloat {
 		return NewBigFloat(math.NaN(), prec)
 	}
 
-	// Initial guess using float64 sqrt
-	xFloat, _ := x.Float64()
-	guess := NewBigFloat(math.Sqrt(xFloat), prec)
-
-	// Newton-Raphson: x_{n+1} = (x_n + S/x_n) / 2
-	// Iterate until convergence
-	two := NewBigFloat(2.0, prec)
-	temp := new(BigFloat).SetPrec(prec)
-	diff := new(BigFloat).SetPrec(prec)
-	threshold := new(BigFloat).SetPrec(prec).SetFloat64(1e-77) // Convergence threshold
-
-	for i := 0; i < 100; i++ { // Max 100 iterations
-		//nolint:gocritic // Documentation comment explaining algorithm step
-		// temp = S / guess
-		temp.Quo(x, guess)
-
-		//nolint:gocritic // Documentation comment explaining algorithm step
-		// temp = guess + S/guess
-		temp.Add(guess, temp)
-
-		//nolint:gocritic // Documentation comment explaining algorithm step
-		// guess_new = (guess + S/guess) / 2
-		temp.Quo(temp, two)
-
-		// Check convergence: |guess_new - guess|
-		diff.Sub(temp, guess)
-		diff = BigAbs(diff, prec)
-
-		guess.Set(temp)
-
-		if diff.Cmp(threshold) < 0 {
-			break
-		}
-	}
-
-	return guess
+	// big.Float.Sqrt is correctly rounded at any precision and exponent range,
+	// unlike a float64-seeded Newton iteration with a fixed stopping threshold
+	return new(BigFloat).SetPrec(prec).Sqrt(x)
 }
 
 // BigSqrtRounded computes sqrt(x) and rounds the result according to the mode
@@ -0,0 +1,175 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"container/list"
+	"sync"
+)
+
+// DefaultCacheSize is the number of results kept by the transcendental-value cache
+const DefaultCacheSize = 256
+
+// BigFloatKey returns a string that uniquely identifies the exact value of x.
+// The mantissa is encoded in hexadecimal with a binary exponent, so the key is
+// exact at any precision and independent of decimal formatting.
+func BigFloatKey(x *BigFloat) string {
+	if x == nil {
+		return "nil"
+	}
+	return x.Text('p', 0)
+}
+
+// cacheKey identifies a cached result by function, argument value and precision
+type cacheKey struct {
+	fn   string
+	val  string
+	prec uint
+}
+
+// cacheEntry is stored in the LRU list
+type cacheEntry struct {
+	key   cacheKey
+	value *BigFloat
+}
+
+// transcendentalCache is a size-bounded LRU cache safe for concurrent use
+type transcendentalCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[cacheKey]*list.Element
+}
+
+var valueCache = newTranscendentalCache(DefaultCacheSize)
+
+// newTranscendentalCache creates an empty cache holding at most capacity entries
+func newTranscendentalCache(capacity int) *transcendentalCache {
+	return &transcendentalCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[cacheKey]*list.Element),
+	}
+}
+
+// get returns the cached value for key, marking it as most recently used
+func (c *transcendentalCache) get(key cacheKey) (*BigFloat, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).value, true
+}
+
+// put stores value under key, evicting the least recently used entries if needed
+func (c *transcendentalCache) put(key cacheKey, value *BigFloat) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.capacity <= 0 {
+		return
+	}
+	if elem, ok := c.items[key]; ok {
+		c.ll.MoveToFront(elem)
+		elem.Value.(*cacheEntry).value = value
+		return
+	}
+	c.items[key] = c.ll.PushFront(&cacheEntry{key: key, value: value})
+	c.evict()
+}
+
+// evict removes entries from the back of the list until the size fits capacity.
+// The caller must hold c.mu.
+func (c *transcendentalCache) evict() {
+	for c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// resize changes the capacity, evicting entries if the cache shrinks
+func (c *transcendentalCache) resize(capacity int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if capacity < 0 {
+		capacity = 0
+	}
+	c.capacity = capacity
+	c.evict()
+}
+
+// clear removes all entries
+func (c *transcendentalCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ll.Init()
+	c.items = make(map[cacheKey]*list.Element)
+}
+
+// len returns the number of cached entries
+func (c *transcendentalCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ll.Len()
+}
+
+// SetCacheSize sets the maximum number of results kept by the cached
+// transcendental functions. A size of zero or less disables caching.
+func SetCacheSize(n int) {
+	valueCache.resize(n)
+}
+
+// ClearCache removes all results from the transcendental-value cache
+func ClearCache() {
+	valueCache.clear()
+}
+
+// cachedCall returns fn(x, prec) from the cache, computing and storing it on a miss.
+// The returned value is always a fresh copy so callers may modify it freely.
+func cachedCall(name string, fn func(x *BigFloat, prec uint) *BigFloat, x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	key := cacheKey{fn: name, val: BigFloatKey(x), prec: prec}
+	if value, ok := valueCache.get(key); ok {
+		return new(BigFloat).SetPrec(value.Prec()).Set(value)
+	}
+
+	value := fn(x, prec)
+	valueCache.put(key, new(BigFloat).SetPrec(value.Prec()).Set(value))
+	return value
+}
+
+// CachedBigSin computes sin(x) like BigSin, reusing a previous result for the
+// same argument value and precision when available
+func CachedBigSin(x *BigFloat, prec uint) *BigFloat {
+	return cachedCall("sin", BigSin, x, prec)
+}
+
+// CachedBigCos computes cos(x) like BigCos, reusing a previous result for the
+// same argument value and precision when available
+func CachedBigCos(x *BigFloat, prec uint) *BigFloat {
+	return cachedCall("cos", BigCos, x, prec)
+}
+
+// CachedBigExp computes e^x like BigExp, reusing a previous result for the
+// same argument value and precision when available
+func CachedBigExp(x *BigFloat, prec uint) *BigFloat {
+	return cachedCall("exp", BigExp, x, prec)
+}
+
+// CachedBigLog computes ln(x) like BigLog, reusing a previous result for the
+// same argument value and precision when available
+func CachedBigLog(x *BigFloat, prec uint) *BigFloat {
+	return cachedCall("log", BigLog, x, prec)
+}
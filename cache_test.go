@@ -0,0 +1,180 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestCachedFunctionsMatchUncached tests that cached results equal direct computation
+func TestCachedFunctionsMatchUncached(t *testing.T) {
+	defer SetCacheSize(DefaultCacheSize)
+	ClearCache()
+
+	prec := uint(256)
+	x := NewBigFloat(0.40909280422232897, prec) // obliquity of the ecliptic in radians
+
+	funcs := []struct {
+		name   string
+		cached func(*BigFloat, uint) *BigFloat
+		direct func(*BigFloat, uint) *BigFloat
+	}{
+		{"sin", CachedBigSin, BigSin},
+		{"cos", CachedBigCos, BigCos},
+		{"exp", CachedBigExp, BigExp},
+		{"log", CachedBigLog, BigLog},
+	}
+
+	for _, f := range funcs {
+		t.Run(f.name, func(t *testing.T) {
+			want := f.direct(x, prec)
+			first := f.cached(x, prec)
+			second := f.cached(x, prec)
+
+			if first.Cmp(want) != 0 {
+				t.Errorf("Cached %s (miss) = %s, want %s", f.name, first.Text('g', 30), want.Text('g', 30))
+			}
+			if second.Cmp(want) != 0 {
+				t.Errorf("Cached %s (hit) = %s, want %s", f.name, second.Text('g', 30), want.Text('g', 30))
+			}
+			if first == second {
+				t.Errorf("Cached %s returned the same pointer twice; callers must get copies", f.name)
+			}
+
+			// Mutating a returned value must not corrupt the cache
+			second.SetInt64(42)
+			third := f.cached(x, prec)
+			if third.Cmp(want) != 0 {
+				t.Errorf("Cached %s corrupted by caller mutation: %s", f.name, third.Text('g', 30))
+			}
+		})
+	}
+
+	if n := valueCache.len(); n != len(funcs) {
+		t.Errorf("cache length = %d, want %d", n, len(funcs))
+	}
+}
+
+// TestCacheKeyIncludesPrecision tests that different precisions are cached separately
+func TestCacheKeyIncludesPrecision(t *testing.T) {
+	defer SetCacheSize(DefaultCacheSize)
+	ClearCache()
+
+	x := NewBigFloat(1.0, 256)
+	low := CachedBigSin(x, 64)
+	high := CachedBigSin(x, 256)
+
+	if low.Prec() != 64 || high.Prec() != 256 {
+		t.Errorf("precisions = (%d, %d), want (64, 256)", low.Prec(), high.Prec())
+	}
+	if valueCache.len() != 2 {
+		t.Errorf("cache length = %d, want 2", valueCache.len())
+	}
+}
+
+// TestCacheEviction tests that the cache never exceeds the configured size
+func TestCacheEviction(t *testing.T) {
+	defer SetCacheSize(DefaultCacheSize)
+	ClearCache()
+	SetCacheSize(3)
+
+	prec := uint(128)
+	for i := 1; i <= 10; i++ {
+		CachedBigExp(NewBigFloat(float64(i), prec), prec)
+		if n := valueCache.len(); n > 3 {
+			t.Fatalf("cache length = %d after %d inserts, want <= 3", n, i)
+		}
+	}
+
+	// The most recent entry must still be cached, the oldest must be gone
+	if _, ok := valueCache.get(cacheKey{fn: "exp", val: BigFloatKey(NewBigFloat(10, prec)), prec: prec}); !ok {
+		t.Error("most recent entry was evicted")
+	}
+	if _, ok := valueCache.get(cacheKey{fn: "exp", val: BigFloatKey(NewBigFloat(1, prec)), prec: prec}); ok {
+		t.Error("oldest entry was not evicted")
+	}
+
+	// Shrinking evicts immediately
+	SetCacheSize(1)
+	if n := valueCache.len(); n != 1 {
+		t.Errorf("cache length after shrink = %d, want 1", n)
+	}
+
+	// Zero disables caching
+	SetCacheSize(0)
+	CachedBigExp(NewBigFloat(0.5, prec), prec)
+	if n := valueCache.len(); n != 0 {
+		t.Errorf("cache length with size 0 = %d, want 0", n)
+	}
+
+	SetCacheSize(3)
+	CachedBigExp(NewBigFloat(0.5, prec), prec)
+	ClearCache()
+	if n := valueCache.len(); n != 0 {
+		t.Errorf("cache length after ClearCache = %d, want 0", n)
+	}
+}
+
+// TestCacheConcurrentAccess tests the cache under parallel access
+func TestCacheConcurrentAccess(t *testing.T) {
+	defer SetCacheSize(DefaultCacheSize)
+	ClearCache()
+	SetCacheSize(4)
+
+	prec := uint(128)
+	args := []*BigFloat{
+		NewBigFloat(0.1, prec),
+		NewBigFloat(0.2, prec),
+		NewBigFloat(0.3, prec),
+		NewBigFloat(0.4, prec),
+		NewBigFloat(0.5, prec),
+		NewBigFloat(0.6, prec),
+	}
+	want := make([]*BigFloat, len(args))
+	for i, a := range args {
+		want[i] = BigCos(a, prec)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 64)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 30; i++ {
+				idx := (g + i) % len(args)
+				got := CachedBigCos(args[idx], prec)
+				if got.Cmp(want[idx]) != 0 {
+					select {
+					case errs <- got.Text('g', 20):
+					default:
+					}
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	close(errs)
+
+	for e := range errs {
+		t.Errorf("concurrent CachedBigCos returned wrong value %s", e)
+	}
+	if n := valueCache.len(); n > 4 {
+		t.Errorf("cache length = %d, want <= 4", n)
+	}
+}
+
+// TestBigFloatKey tests that keys distinguish values exactly
+func TestBigFloatKey(t *testing.T) {
+	a := NewBigFloat(1.0, 256)
+	b := new(BigFloat).SetPrec(256).Add(a, new(BigFloat).SetMantExp(NewBigFloat(1.0, 256), -200))
+
+	if BigFloatKey(a) == BigFloatKey(b) {
+		t.Errorf("BigFloatKey does not distinguish values differing at bit 200")
+	}
+	if BigFloatKey(a) != BigFloatKey(NewBigFloat(1.0, 64)) {
+		t.Errorf("BigFloatKey should depend on value only, not precision")
+	}
+}
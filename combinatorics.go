@@ -3,27 +3,154 @@
 
 package bigmath
 
-// BigFactorial computes n! (factorial) using Gamma function
-// n! = Γ(n+1)
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// BigFactorial computes n! (factorial)
+// The integer product is formed exactly and rounded once to prec, so the
+// result is exact whenever n! fits in prec bits. Very large n use Γ(n+1).
 func BigFactorial(n int64, prec uint) *BigFloat {
 	return getDispatcher().BigFactorialImpl(n, prec)
 }
 
+// BigFactorialFloat computes the generalized factorial x! = Γ(x+1)
+// Non-negative integers give the same value as BigFactorial. Other values are
+// computed as ±exp(ln|Γ(x+1)|) from BigLogGamma, which is accurate to the full
+// precision. Returns +Inf at the poles (x a negative integer).
+func BigFactorialFloat(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	if x.IsInt() && x.Sign() >= 0 {
+		if n, acc := x.Int64(); acc == big.Exact {
+			return BigFactorial(n, prec)
+		}
+	}
+
+	// exp amplifies the absolute error of ln Γ, which grows like x·log2(x)
+	workPrec := prec + 32
+	if exp := x.MantExp(nil); exp > 0 {
+		workPrec += 2 * uint(exp)
+	}
+
+	xPlusOne := new(BigFloat).SetPrec(workPrec).Add(x, NewBigFloat(1.0, workPrec))
+	if bigGammaIsPole(xPlusOne) {
+		return new(BigFloat).SetPrec(prec).SetInf(false)
+	}
+
+	result := BigExp(BigLogGamma(xPlusOne, workPrec), workPrec)
+	if bigGammaIsNegative(xPlusOne) {
+		result.Neg(result)
+	}
+	return new(BigFloat).SetPrec(prec).Set(result)
+}
+
+// BigDoubleFactorial computes n!! = n(n-2)(n-4)..., ending at 1 or 2
+// Uses the conventions 0!! = (-1)!! = 1. The integer product is formed exactly
+// and rounded once to prec. n < -1 is undefined and, like negative n in
+// BigFactorial, yields NewBigFloat(NaN), which is 0.
+func BigDoubleFactorial(n int, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+	if n < -1 {
+		return NewBigFloat(math.NaN(), prec)
+	}
+
+	product := big.NewInt(1)
+	factor := new(big.Int)
+	for i := n; i > 1; i -= 2 {
+		product.Mul(product, factor.SetInt64(int64(i)))
+	}
+	return new(BigFloat).SetPrec(prec).SetInt(product)
+}
+
 // BigBinomial computes the binomial coefficient C(n, k) = n! / (k! * (n-k)!)
-// Uses optimized computation to avoid computing large factorials
+// The coefficient is formed exactly with the multiplicative formula and rounded
+// once to prec. Returns 0 for k < 0 or k > n.
 func BigBinomial(n, k int64, prec uint) *BigFloat {
 	return getDispatcher().BigBinomialImpl(n, k, prec)
 }
 
-// bigBinomialDirect computes binomial coefficient directly for small values
-func bigBinomialDirect(n, k int64, prec uint) *BigFloat {
-	// C(n, k) = n! / (k! * (n-k)!)
-	nFact := BigFactorial(n, prec)
-	kFact := BigFactorial(k, prec)
-	nMinusKFact := BigFactorial(n-k, prec)
+// BigBinomialGeneralized computes C(x, k) = x(x-1)...(x-k+1) / k! for a real
+// upper index x. Returns 0 for k < 0 and 1 for k = 0.
+func BigBinomialGeneralized(x *BigFloat, k int, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	if k < 0 {
+		return NewBigFloat(0.0, prec)
+	}
+	if x.IsInt() && x.Sign() >= 0 {
+		if n, acc := x.Int64(); acc == big.Exact {
+			return BigBinomial(n, int64(k), prec)
+		}
+	}
+
+	// Each of the 2k operations rounds once
+	workPrec := prec + 32 + uint(bits.Len(uint(k)))
+	result := NewBigFloat(1.0, workPrec)
+	term := new(BigFloat).SetPrec(workPrec)
+	for i := 0; i < k; i++ {
+		term.Sub(x, term.SetInt64(int64(i)))
+		result.Mul(result, term)
+		result.Quo(result, term.SetInt64(int64(i+1)))
+	}
+	return new(BigFloat).SetPrec(prec).Set(result)
+}
+
+// bigIntegerArgs converts the integer-valued a and b to big.Int, naming fn in
+// the error for non-integer (or infinite) input
+func bigIntegerArgs(fn string, a, b *BigFloat) (*big.Int, *big.Int, error) {
+	for _, x := range []*BigFloat{a, b} {
+		if !x.IsInt() {
+			return nil, nil, fmt.Errorf("%s requires integer arguments, got %s", fn, x.Text('g', 20))
+		}
+	}
+	ai, _ := a.Int(nil)
+	bi, _ := b.Int(nil)
+	return ai, bi, nil
+}
+
+// BigGCD returns the greatest common divisor of the integer values a and b
+// The result is non-negative, with gcd(0, 0) = 0. It is computed exactly and
+// rounded once to prec (0 means the precision of a). Returns an error if a or
+// b is not an integer.
+func BigGCD(a, b *BigFloat, prec uint) (*BigFloat, error) {
+	if prec == 0 {
+		prec = a.Prec()
+	}
+	ai, bi, err := bigIntegerArgs("gcd", a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	g := new(big.Int).GCD(nil, nil, ai.Abs(ai), bi.Abs(bi))
+	return new(BigFloat).SetPrec(prec).SetInt(g), nil
+}
 
-	denom := new(BigFloat).SetPrec(prec).Mul(kFact, nMinusKFact)
-	result := new(BigFloat).SetPrec(prec).Quo(nFact, denom)
+// BigLCM returns the least common multiple |a·b|/gcd(a, b) of the integer
+// values a and b, with lcm(0, b) = 0. Precision and errors are as for BigGCD.
+func BigLCM(a, b *BigFloat, prec uint) (*BigFloat, error) {
+	if prec == 0 {
+		prec = a.Prec()
+	}
+	ai, bi, err := bigIntegerArgs("lcm", a, b)
+	if err != nil {
+		return nil, err
+	}
+	if ai.Sign() == 0 || bi.Sign() == 0 {
+		return new(BigFloat).SetPrec(prec), nil
+	}
 
-	return result
+	ai.Abs(ai)
+	bi.Abs(bi)
+	l := new(big.Int).GCD(nil, nil, ai, bi)
+	l.Quo(ai, l)
+	l.Mul(l, bi)
+	return new(BigFloat).SetPrec(prec).SetInt(l), nil
 }
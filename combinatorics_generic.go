@@ -5,6 +5,7 @@ package bigmath
 
 import (
 	"math"
+	"math/big"
 )
 
 // Generic implementations for combinatorics functions (used as fallback)
@@ -32,11 +33,21 @@ func bigFactorialGeneric(n int64, prec uint) *BigFloat {
 		return result
 	}
 
-	// For large n, use Gamma function: n! = Γ(n+1)
+	// Up to factorialExactLimit the exact integer product is cheap, and
+	// SetInt rounds it correctly (exactly while it fits in prec bits)
+	if n <= factorialExactLimit {
+		return new(BigFloat).SetPrec(prec).SetInt(new(big.Int).MulRange(1, n))
+	}
+
+	// For very large n, use Gamma function: n! = Γ(n+1)
 	nPlusOne := NewBigFloat(float64(n+1), prec)
 	return BigGamma(nPlusOne, prec)
 }
 
+// factorialExactLimit is the largest n for which bigFactorialGeneric forms
+// n! as an exact integer product instead of using Γ(n+1)
+const factorialExactLimit = 10000
+
 // bigBinomialGeneric computes the binomial coefficient C(n, k) using pure Go implementation
 func bigBinomialGeneric(n, k int64, prec uint) *BigFloat {
 	if prec == 0 {
@@ -51,25 +62,7 @@ func bigBinomialGeneric(n, k int64, prec uint) *BigFloat {
 		return NewBigFloat(1.0, prec)
 	}
 
-	// Use symmetry: C(n, k) = C(n, n-k)
-	if k > n-k {
-		k = n - k
-	}
-
-	// For small values, compute directly
-	if n <= 20 {
-		return bigBinomialDirect(n, k, prec)
-	}
-
-	// For larger values, compute incrementally
-	result := NewBigFloat(1.0, prec)
-
-	for i := int64(0); i < k; i++ {
-		numerator := NewBigFloat(float64(n-i), prec)
-		denominator := NewBigFloat(float64(k-i), prec)
-		term := new(BigFloat).SetPrec(prec).Quo(numerator, denominator)
-		result.Mul(result, term)
-	}
-
-	return result
+	// big.Int.Binomial uses the multiplicative formula on exact integers, so
+	// the only rounding is the final conversion to prec
+	return new(BigFloat).SetPrec(prec).SetInt(new(big.Int).Binomial(n, k))
 }
@@ -36,40 +36,9 @@ func bigBinomialOptimized(n, k int64, prec uint) *BigFloat {
 		return NewBigFloat(1.0, prec)
 	}
 	if k == 1 || k == n-1 {
-		return NewBigFloat(float64(n), prec)
+		return new(BigFloat).SetPrec(prec).SetInt64(n)
 	}
 
-	// Use symmetry: C(n,k) = C(n, n-k)
-	if k > n-k {
-		k = n - k
-	}
-
-	// For small k, use direct computation
-	if k <= 5 {
-		return bigBinomialDirectOptimized(n, k, prec)
-	}
-
-	// For larger values, use original implementation
+	// Everything else is computed exactly by the generic implementation
 	return bigBinomialGeneric(n, k, prec)
 }
-
-// bigBinomialDirectOptimized computes C(n,k) directly for small k
-// Optimization: Accumulate multiplications, reduce divisions
-func bigBinomialDirectOptimized(n, k int64, prec uint) *BigFloat {
-	result := NewBigFloat(1.0, prec)
-	temp := new(BigFloat).SetPrec(prec)
-
-	// Compute n!/(n-k)! / k!
-	// = (n * (n-1) * ... * (n-k+1)) / (k * (k-1) * ... * 1)
-	for i := int64(0); i < k; i++ {
-		// Multiply by (n-i)
-		temp.SetFloat64(float64(n - i))
-		result.Mul(result, temp)
-
-		// Divide by (i+1)
-		temp.SetFloat64(float64(i + 1))
-		result.Quo(result, temp)
-	}
-
-	return result
-}
@@ -4,6 +4,8 @@
 package bigmath
 
 import (
+	"fmt"
+	"math/big"
 	"testing"
 )
 
@@ -104,6 +106,76 @@ func TestBigFactorial(t *testing.T) {
 	})
 }
 
+func TestBigFactorialExact(t *testing.T) {
+	prec := uint(256)
+
+	// 50! has 215 significant bits, so it is exact at 256
+	for _, n := range []int64{0, 20, 21, 35, 50} {
+		want := new(big.Int).MulRange(1, n)
+		if n == 0 {
+			want.SetInt64(1)
+		}
+		got, acc := BigFactorial(n, prec).Int(nil)
+		if got.Cmp(want) != 0 || acc != big.Exact {
+			t.Errorf("BigFactorial(%d) = %s, want %s exactly", n, got, want)
+		}
+	}
+
+	// Beyond prec bits the result is the correctly rounded integer product
+	exact := new(BigFloat).SetPrec(64).SetInt(new(big.Int).MulRange(1, 300))
+	if got := BigFactorial(300, 64); got.Cmp(exact) != 0 {
+		t.Errorf("BigFactorial(300) at prec 64 = %s, want %s", got.Text('g', 20), exact.Text('g', 20))
+	}
+}
+
+func TestBigFactorialFloat(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	t.Run("half", func(t *testing.T) {
+		// 0.5! = Γ(1.5) = √π/2
+		want := BigSqrt(BigPI(prec), prec)
+		want.Quo(want, NewBigFloat(2, prec))
+		if got := BigFactorialFloat(NewBigFloat(0.5, prec), prec); !BigFloatEqual(got, want, tol) {
+			t.Errorf("BigFactorialFloat(0.5) = %s, want √π/2 = %s", got.Text('g', 50), want.Text('g', 50))
+		}
+	})
+
+	t.Run("integers", func(t *testing.T) {
+		for _, n := range []int64{0, 1, 7, 20, 30} {
+			got := BigFactorialFloat(NewBigFloat(float64(n), prec), prec)
+			if want := BigFactorial(n, prec); got.Cmp(want) != 0 {
+				t.Errorf("BigFactorialFloat(%d) = %s, want %s", n, got.Text('g', 40), want.Text('g', 40))
+			}
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		// (-0.5)! = Γ(0.5) = √π and (-1.5)! = Γ(-0.5) = -2√π
+		sqrtPi := BigSqrt(BigPI(prec), prec)
+		if got := BigFactorialFloat(NewBigFloat(-0.5, prec), prec); !BigFloatEqual(got, sqrtPi, tol) {
+			t.Errorf("(-0.5)! = %s, want √π", got.Text('g', 50))
+		}
+		want := new(BigFloat).SetPrec(prec).Mul(sqrtPi, NewBigFloat(-2, prec))
+		if got := BigFactorialFloat(NewBigFloat(-1.5, prec), prec); !BigFloatEqual(got, want, tol) {
+			t.Errorf("(-1.5)! = %s, want -2√π", got.Text('g', 50))
+		}
+		if got := BigFactorialFloat(NewBigFloat(-3, prec), prec); !got.IsInf() {
+			t.Errorf("(-3)! = %s, want +Inf", got.Text('g', 20))
+		}
+	})
+
+	t.Run("recurrence", func(t *testing.T) {
+		// x! = x·(x-1)!
+		x := NewBigFloat(3.75, prec)
+		xm1 := NewBigFloat(2.75, prec)
+		want := new(BigFloat).SetPrec(prec).Mul(x, BigFactorialFloat(xm1, prec))
+		if got := BigFactorialFloat(x, prec); !BigFloatEqual(got, want, tol) {
+			t.Errorf("3.75! = %s, want 3.75·2.75! = %s", got.Text('g', 50), want.Text('g', 50))
+		}
+	})
+}
+
 func TestBigBinomial(t *testing.T) {
 	prec := uint(256)
 
@@ -232,3 +304,161 @@ func TestBigBinomial(t *testing.T) {
 		}
 	})
 }
+
+func TestBigBinomialExact(t *testing.T) {
+	prec := uint(256)
+
+	if got := BigBinomial(20, 5, prec); got.Cmp(NewBigFloat(15504, prec)) != 0 {
+		t.Errorf("C(20, 5) = %s, want 15504", got.Text('f', 0))
+	}
+
+	// C(200, 100) ≈ 9e58 needs 196 bits, far beyond float64
+	want := new(BigFloat).SetPrec(prec).SetInt(new(big.Int).Binomial(200, 100))
+	if got := BigBinomial(200, 100, prec); got.Cmp(want) != 0 {
+		t.Errorf("C(200, 100) = %s, want %s", got.Text('f', 0), want.Text('f', 0))
+	}
+
+	// n beyond 2^53 is not representable in float64
+	n := int64(1)<<60 + 1
+	want = new(BigFloat).SetPrec(prec).SetInt(new(big.Int).Binomial(n, 3))
+	if got := BigBinomial(n, 3, prec); got.Cmp(want) != 0 {
+		t.Errorf("C(2^60+1, 3) = %s, want %s", got.Text('f', 0), want.Text('f', 0))
+	}
+}
+
+func TestBigBinomialGeneralized(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	tests := []struct {
+		name string
+		x    string
+		k    int
+		want string
+	}{
+		{"C(-1,3)", "-1", 3, "-1"},
+		{"C(-1,4)", "-1", 4, "1"},
+		{"C(-2,3)", "-2", 3, "-4"},
+		{"C(0.5,2)", "0.5", 2, "-0.125"},
+		{"C(0.5,3)", "0.5", 3, "0.0625"},
+		{"C(2.5,0)", "2.5", 0, "1"},
+		{"C(2.5,-1)", "2.5", -1, "0"},
+		{"C(20,5)", "20", 5, "15504"},
+		{"C(3,5)", "3", 5, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, _ := NewBigFloatFromString(tt.x, prec)
+			want, _ := NewBigFloatFromString(tt.want, prec)
+			if got := BigBinomialGeneralized(x, tt.k, prec); !BigFloatEqual(got, want, tol) {
+				t.Errorf("C(%s, %d) = %s, want %s", tt.x, tt.k, got.Text('g', 40), tt.want)
+			}
+		})
+	}
+}
+
+func TestBigDoubleFactorial(t *testing.T) {
+	prec := uint(256)
+
+	tests := []struct {
+		n    int
+		want int64
+	}{
+		{-1, 1},
+		{0, 1},
+		{1, 1},
+		{2, 2},
+		{5, 15},
+		{6, 48},
+		{9, 945},
+		{10, 3840},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d!!", tt.n), func(t *testing.T) {
+			if got := BigDoubleFactorial(tt.n, prec); got.Cmp(NewBigFloat(float64(tt.want), prec)) != 0 {
+				t.Errorf("%d!! = %s, want %d", tt.n, got.Text('f', 0), tt.want)
+			}
+		})
+	}
+
+	// (2n)!!·(2n-1)!! = (2n)!, exactly while (2n)! fits in prec bits
+	t.Run("product_property", func(t *testing.T) {
+		for n := 1; n <= 28; n++ {
+			got := new(BigFloat).SetPrec(prec).Mul(BigDoubleFactorial(2*n, prec), BigDoubleFactorial(2*n-1, prec))
+			if want := BigFactorial(int64(2*n), prec); got.Cmp(want) != 0 {
+				t.Errorf("(%d)!!·(%d)!! = %s, want %d! = %s", 2*n, 2*n-1, got.Text('f', 0), 2*n, want.Text('f', 0))
+			}
+		}
+	})
+
+	// 301!! needs about 1000 bits; at prec 256 it must be the correctly
+	// rounded exact product
+	t.Run("large", func(t *testing.T) {
+		exact := big.NewInt(1)
+		for i := int64(301); i > 1; i -= 2 {
+			exact.Mul(exact, big.NewInt(i))
+		}
+		want := new(BigFloat).SetPrec(prec).SetInt(exact)
+		if got := BigDoubleFactorial(301, prec); got.Cmp(want) != 0 {
+			t.Errorf("301!! = %s, want %s", got.Text('g', 40), want.Text('g', 40))
+		}
+	})
+}
+
+func TestBigGCDLCM(t *testing.T) {
+	prec := uint(256)
+
+	tests := []struct {
+		a, b     string
+		gcd, lcm string
+	}{
+		{"48", "36", "12", "144"},
+		{"4", "6", "2", "12"},
+		{"-48", "36", "12", "144"},
+		{"17", "5", "1", "85"},
+		{"0", "9", "9", "0"},
+		{"0", "0", "0", "0"},
+		// Beyond float64's 2^53 integer range
+		{"123456789012345678901234567890", "987654321098765432109876543210", "9000000000900000000090", "13548070124980948012498094801236261410"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+","+tt.b, func(t *testing.T) {
+			a, _ := NewBigFloatFromString(tt.a, prec)
+			b, _ := NewBigFloatFromString(tt.b, prec)
+
+			g, err := BigGCD(a, b, prec)
+			if err != nil {
+				t.Fatalf("BigGCD error: %v", err)
+			}
+			if want, _ := NewBigFloatFromString(tt.gcd, prec); g.Cmp(want) != 0 {
+				t.Errorf("gcd(%s, %s) = %s, want %s", tt.a, tt.b, g.Text('f', 0), tt.gcd)
+			}
+
+			l, err := BigLCM(a, b, prec)
+			if err != nil {
+				t.Fatalf("BigLCM error: %v", err)
+			}
+			if want, _ := NewBigFloatFromString(tt.lcm, prec); l.Cmp(want) != 0 {
+				t.Errorf("lcm(%s, %s) = %s, want %s", tt.a, tt.b, l.Text('f', 0), tt.lcm)
+			}
+		})
+	}
+
+	t.Run("non_integer", func(t *testing.T) {
+		x := NewBigFloat(2.5, prec)
+		four := NewBigFloat(4, prec)
+		if _, err := BigGCD(x, four, prec); err == nil {
+			t.Error("BigGCD(2.5, 4) returned nil error")
+		}
+		if _, err := BigLCM(four, x, prec); err == nil {
+			t.Error("BigLCM(4, 2.5) returned nil error")
+		}
+		inf := new(BigFloat).SetInf(false)
+		if _, err := BigGCD(inf, four, prec); err == nil {
+			t.Error("BigGCD(+Inf, 4) returned nil error")
+		}
+	})
+}
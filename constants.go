@@ -3,9 +3,11 @@
 
 package bigmath
 
+import "sync"
+
 // Constants exported from assembly data sections
 // These are high-precision precomputed values
-// Note: bigPI, bigTwoPI, bigHalfPI are already declared in bigmath.go as *BigFloat
+// Note: π, ln(2) and ln(10) are cached per precision by the constantCache values in bigmath.go
 // These assembly constants can be used for low-level operations if needed
 
 // LoadConstants loads constants from assembly data sections
@@ -16,3 +18,58 @@ func loadConstants() {
 	// Constants are loaded from assembly data sections
 	// The actual loading happens at link time
 }
+
+// constantCacheBucket is the granularity, in bits, of the precisions a
+// constantCache stores. Callers often ask for data-dependent precisions such
+// as prec+exponent, and bucketing keeps those from each starting a fresh
+// computation and a permanent map entry.
+const constantCacheBucket = 64
+
+// constantCache holds a mathematical constant computed at each requested
+// precision, rounded up to a multiple of constantCacheBucket bits, so callers
+// asking for more than DefaultPrecision bits get a correctly computed value
+// rather than a widened 256-bit one
+type constantCache struct {
+	mu      sync.RWMutex
+	values  map[uint]*BigFloat
+	compute func(prec uint) *BigFloat
+}
+
+// newConstantCache creates an empty cache for the constant computed by compute
+func newConstantCache(compute func(prec uint) *BigFloat) *constantCache {
+	return &constantCache{
+		values:  make(map[uint]*BigFloat),
+		compute: compute,
+	}
+}
+
+// constantCachePrec rounds prec up to the next multiple of constantCacheBucket
+func constantCachePrec(prec uint) uint {
+	return (prec + constantCacheBucket - 1) / constantCacheBucket * constantCacheBucket
+}
+
+// get returns a copy of the constant rounded to precision prec (0 uses
+// DefaultPrecision), computing and caching it at the bucket precision on first use
+func (c *constantCache) get(prec uint) *BigFloat {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+	bucket := constantCachePrec(prec)
+
+	c.mu.RLock()
+	v, ok := c.values[bucket]
+	c.mu.RUnlock()
+
+	if !ok {
+		v = c.compute(bucket)
+		c.mu.Lock()
+		if existing, found := c.values[bucket]; found {
+			v = existing
+		} else {
+			c.values[bucket] = v
+		}
+		c.mu.Unlock()
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(v)
+}
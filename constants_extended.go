@@ -3,29 +3,27 @@
 
 package bigmath
 
-// BigPhi returns the golden ratio φ = (1 + √5) / 2 ≈ 1.6180339887498948482...
-// with specified precision
+// BigPhi returns the golden ratio φ = (1 + √5) / 2 ≈ 1.6180339887498948482... with specified precision
 func BigPhi(prec uint) *BigFloat {
-	if prec == 0 {
-		prec = DefaultPrecision
-	}
-
-	// φ = (1 + √5) / 2
-	sqrt5 := BigSqrt(NewBigFloat(5.0, prec), prec)
-	one := NewBigFloat(1.0, prec)
-	numerator := new(BigFloat).SetPrec(prec).Add(one, sqrt5)
-	two := NewBigFloat(2.0, prec)
-	result := new(BigFloat).SetPrec(prec).Quo(numerator, two)
+	return phiCache.get(prec)
+}
 
-	return result
+// computePhi computes φ = (1 + √5) / 2
+func computePhi(prec uint) *BigFloat {
+	workPrec := prec + 8
+	sqrt5 := BigSqrt(NewBigFloat(5.0, workPrec), workPrec)
+	phi := new(BigFloat).SetPrec(workPrec).Add(NewBigFloat(1.0, workPrec), sqrt5)
+	phi.Quo(phi, NewBigFloat(2.0, workPrec))
+	return new(BigFloat).SetPrec(prec).Set(phi)
 }
 
 // BigSqrt2 returns √2 ≈ 1.4142135623730950488... with specified precision
 func BigSqrt2(prec uint) *BigFloat {
-	if prec == 0 {
-		prec = DefaultPrecision
-	}
+	return sqrt2Cache.get(prec)
+}
 
+// computeSqrt2 computes √2
+func computeSqrt2(prec uint) *BigFloat {
 	return BigSqrt(NewBigFloat(2.0, prec), prec)
 }
 
@@ -40,9 +38,48 @@ func BigSqrt3(prec uint) *BigFloat {
 
 // BigLn10 returns ln(10) ≈ 2.3025850929940456840... with specified precision
 func BigLn10(prec uint) *BigFloat {
-	if prec == 0 {
-		prec = DefaultPrecision
+	return ln10Cache.get(prec)
+}
+
+// BigCatalan returns Catalan's constant G ≈ 0.9159655941772190150... with specified precision
+// G is computed once per precision and cached.
+func BigCatalan(prec uint) *BigFloat {
+	return catalanCache.get(prec)
+}
+
+// computeCatalan computes Catalan's constant using Ramanujan's series
+//
+//	G = π/8 · ln(2 + √3) + 3/8 · Σ_{n≥0} (n!)² / ((2n)! · (2n+1)²)
+//
+// whose terms shrink by a factor of 4 each step.
+func computeCatalan(prec uint) *BigFloat {
+	workPrec := prec + 32
+
+	// ratio carries (n!)² / (2n)!, which is multiplied by n / (2(2n-1)) at each step
+	ratio := NewBigFloat(1.0, workPrec)
+	sum := NewBigFloat(1.0, workPrec)
+	term := new(BigFloat).SetPrec(workPrec)
+	for n := int64(1); ; n++ {
+		ratio.Mul(ratio, NewBigFloat(float64(n), workPrec))
+		ratio.Quo(ratio, NewBigFloat(float64(2*(2*n-1)), workPrec))
+
+		odd := NewBigFloat(float64(2*n+1), workPrec)
+		term.Quo(ratio, odd)
+		term.Quo(term, odd)
+		sum.Add(sum, term)
+
+		if term.MantExp(nil) < sum.MantExp(nil)-int(workPrec) {
+			break
+		}
 	}
+	sum.Mul(sum, NewBigFloat(3.0/8.0, workPrec))
+
+	// π/8 · ln(2 + √3)
+	logTerm := new(BigFloat).SetPrec(workPrec).Add(NewBigFloat(2.0, workPrec), BigSqrt(NewBigFloat(3.0, workPrec), workPrec))
+	logTerm = BigLog(logTerm, workPrec)
+	logTerm.Mul(logTerm, BigPI(workPrec))
+	logTerm.Quo(logTerm, NewBigFloat(8.0, workPrec))
 
-	return BigLog(NewBigFloat(10.0, prec), prec)
+	sum.Add(sum, logTerm)
+	return new(BigFloat).SetPrec(prec).Set(sum)
 }
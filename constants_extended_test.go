@@ -195,3 +195,129 @@ func TestBigLn10(t *testing.T) {
 		})
 	}
 }
+
+func TestBigPIHighPrecision(t *testing.T) {
+	// π to 300 significant digits
+	piStr := "3.14159265358979323846264338327950288419716939937510582097494459230781640628620899862803482534211706798214808651328230664709384460955058223172535940812848111745028410270193852110555964462294895493038196442881097566593344612847564823378678316527120190914564856692346034861045432664821339360726024914127"
+
+	for _, prec := range []uint{64, 256, 512, 1024, 2048} {
+		want, err := NewBigFloatFromString(piStr, prec)
+		if err != nil {
+			t.Fatalf("NewBigFloatFromString failed: %v", err)
+		}
+		got := BigPI(prec)
+		if got.Prec() != prec {
+			t.Errorf("BigPI(%d) has precision %d", prec, got.Prec())
+		}
+
+		// 300 digits cover about 996 bits, so beyond that compare to 1e-299
+		diff := new(BigFloat).SetPrec(prec).Sub(got, want)
+		tol, _ := NewBigFloatFromString("1e-299", prec)
+		if prec <= 512 && diff.Sign() != 0 || diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("BigPI(%d) differs from π by %s", prec, diff.Text('g', 5))
+		}
+
+		twoPi := new(BigFloat).SetPrec(prec).Mul(got, NewBigFloat(2.0, prec))
+		halfPi := new(BigFloat).SetPrec(prec).Quo(got, NewBigFloat(2.0, prec))
+		if BigTwoPI(prec).Cmp(twoPi) != 0 || BigHalfPI(prec).Cmp(halfPi) != 0 {
+			t.Errorf("BigTwoPI/BigHalfPI(%d) inconsistent with BigPI", prec)
+		}
+	}
+
+	// Cached values are copies that callers may modify freely
+	pi := BigPI(512)
+	pi.Neg(pi)
+	if BigPI(512).Sign() <= 0 {
+		t.Error("modifying a BigPI result changed the cached value")
+	}
+}
+
+func TestBigEHighPrecision(t *testing.T) {
+	// e to 300 significant digits
+	eStr := "2.71828182845904523536028747135266249775724709369995957496696762772407663035354759457138217852516642742746639193200305992181741359662904357290033429526059563073813232862794349076323382988075319525101901157383418793070215408914993488416750924476146066808226480016847741185374234544243710753907774499207"
+
+	// BigE(256) must agree with the first 77 digits
+	e77, _ := NewBigFloatFromString(eStr[:78], 512)
+	diff := new(BigFloat).SetPrec(512).Sub(BigE(256), e77)
+	if tol, _ := NewBigFloatFromString("1e-76", 512); diff.Abs(diff).Cmp(tol) > 0 {
+		t.Errorf("BigE(256) = %s, want %s", BigE(256).Text('f', 76), eStr[:78])
+	}
+
+	for _, prec := range []uint{64, 256, 512, 1024} {
+		want, _ := NewBigFloatFromString(eStr, prec)
+		got := BigE(prec)
+		diff := new(BigFloat).SetPrec(prec).Sub(got, want)
+		tol, _ := NewBigFloatFromString("1e-299", prec)
+		if prec <= 512 && diff.Sign() != 0 || diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("BigE(%d) differs from e by %s", prec, diff.Text('g', 5))
+		}
+	}
+}
+
+func TestCachedConstantsReferenceValues(t *testing.T) {
+	// Published 50-digit reference values
+	tests := []struct {
+		name string
+		fn   func(uint) *BigFloat
+		ref  string
+	}{
+		{"BigSqrt2", BigSqrt2, "1.4142135623730950488016887242096980785696718753769"},
+		{"BigPhi", BigPhi, "1.6180339887498948482045868343656381177203091798058"},
+		{"BigCatalan", BigCatalan, "0.91596559417721901505460351493238411077414937428167"},
+	}
+
+	for _, tt := range tests {
+		for _, prec := range []uint{192, 256, 512} {
+			t.Run(tt.name, func(t *testing.T) {
+				want, _ := NewBigFloatFromString(tt.ref, prec)
+				diff := new(BigFloat).SetPrec(prec).Sub(tt.fn(prec), want)
+				if tol, _ := NewBigFloatFromString("1e-49", prec); diff.Abs(diff).Cmp(tol) > 0 {
+					t.Errorf("%s(%d) = %s, want %s", tt.name, prec, tt.fn(prec).Text('g', 52), tt.ref)
+				}
+				if got := tt.fn(prec); got.Prec() != prec {
+					t.Errorf("%s(%d) has precision %d", tt.name, prec, got.Prec())
+				}
+			})
+		}
+	}
+
+	t.Run("BigCatalan_high_precision", func(t *testing.T) {
+		// G to 300 significant digits
+		ref := "0.915965594177219015054603514932384110774149374281672134266498119621763019776254769479356512926115106248574422619196199579035898803325859059431594737481158406995332028773319460519038727478164087865909024706484152163000228727640942388259957741508816397470252482011560707644883807873370489900864775113225"
+		prec := uint(1024)
+		want, _ := NewBigFloatFromString(ref, prec)
+		diff := new(BigFloat).SetPrec(prec).Sub(BigCatalan(prec), want)
+		if tol, _ := NewBigFloatFromString("1e-299", prec); diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("BigCatalan(1024) differs from G by %s", diff.Text('g', 5))
+		}
+	})
+}
+
+func TestConstantCacheBuckets(t *testing.T) {
+	var computed []uint
+	cache := newConstantCache(func(prec uint) *BigFloat {
+		computed = append(computed, prec)
+		return computePiChudnovsky(prec)
+	})
+
+	for _, prec := range []uint{100, 65, 128, 129, 150, 192} {
+		got := cache.get(prec)
+		if got.Prec() != prec {
+			t.Errorf("get(%d) precision = %d", prec, got.Prec())
+		}
+
+		// Rounding the bucket value can differ from a direct computation by one ulp
+		want := computePiChudnovsky(prec)
+		ulp := new(BigFloat).SetMantExp(NewBigFloat(1.0, prec), want.MantExp(nil)-int(prec))
+		if diff := new(BigFloat).Sub(got, want); diff.Abs(diff).Cmp(ulp) > 0 {
+			t.Errorf("get(%d) = %s, want %s", prec, got.Text('g', 40), want.Text('g', 40))
+		}
+	}
+
+	if len(computed) != 2 || computed[0] != 128 || computed[1] != 192 {
+		t.Errorf("computed at precisions %v, want [128 192]", computed)
+	}
+	if len(cache.values) != 2 {
+		t.Errorf("cache holds %d entries, want 2", len(cache.values))
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+// ConvergenceInfo reports how an iterative evaluation terminated
+// Iterations is the number of iterations (or series terms) performed,
+// Converged is false if the iteration limit was reached before the internal
+// tolerance, and FinalRelError estimates the relative size of the last
+// correction that was applied.
+type ConvergenceInfo struct {
+	Iterations    int
+	Converged     bool
+	FinalRelError *BigFloat
+}
+
+// BigExpWithInfo computes e^x like BigExp and reports how the Taylor series
+// for the reduced argument converged
+// The value always comes from the portable Go implementation, so the info
+// describes exactly the series that produced it. On amd64 and arm64, where
+// BigExp dispatches to an optimized implementation, the two results can
+// differ in the last bits.
+func BigExpWithInfo(x *BigFloat, prec uint) (*BigFloat, ConvergenceInfo) {
+	return bigExpWithInfo(x, prec, expMaxIterations)
+}
+
+// BigExpWithLimit computes e^x summing at most maxIterations Taylor series
+// terms for the reduced argument. If the limit is reached first, the partial
+// result is returned with Converged set to false. Like BigExpWithInfo it uses
+// the portable Go implementation.
+func BigExpWithLimit(x *BigFloat, prec uint, maxIterations int) (*BigFloat, ConvergenceInfo) {
+	return bigExpWithInfo(x, prec, maxIterations)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"testing"
+)
+
+func TestBigExpWithInfo(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-70", prec)
+
+	t.Run("exp_one", func(t *testing.T) {
+		result, info := BigExpWithInfo(NewBigFloat(1.0, prec), prec)
+		if !info.Converged {
+			t.Errorf("BigExpWithInfo(1) reported Converged = false after %d iterations", info.Iterations)
+		}
+		if info.Iterations <= 0 || info.Iterations > 100 {
+			t.Errorf("BigExpWithInfo(1) took %d iterations, want a modest positive count", info.Iterations)
+		}
+		if info.FinalRelError == nil || info.FinalRelError.Cmp(tolerance) > 0 {
+			t.Errorf("BigExpWithInfo(1) final relative error = %v, want below 1e-70", info.FinalRelError)
+		}
+
+		diff := new(BigFloat).SetPrec(prec).Sub(result, BigE(prec))
+		if diff.Abs(diff).Cmp(tolerance) > 0 {
+			t.Errorf("BigExpWithInfo(1) = %s, want e", result.Text('g', 40))
+		}
+	})
+
+	// The value comes from the generic implementation bit for bit and agrees
+	// with the dispatched BigExp to within rounding
+	t.Run("matches_BigExp", func(t *testing.T) {
+		for _, v := range []float64{-3.5, 0.25, 10.0} {
+			x := NewBigFloat(v, prec)
+			result, _ := BigExpWithInfo(x, prec)
+			want := bigExpGeneric(x, prec)
+			if result.Cmp(want) != 0 {
+				t.Errorf("BigExpWithInfo(%g) = %s, want %s", v, result.Text('g', 40), want.Text('g', 40))
+			}
+
+			dispatched := BigExp(x, prec)
+			diff := new(BigFloat).SetPrec(prec).Sub(result, dispatched)
+			diff.Quo(diff, dispatched)
+			if diff.Abs(diff).Cmp(tolerance) > 0 {
+				t.Errorf("BigExpWithInfo(%g) differs from BigExp by %s relative", v, diff.Text('g', 10))
+			}
+		}
+	})
+
+	t.Run("special_cases", func(t *testing.T) {
+		result, info := BigExpWithInfo(NewBigFloat(0.0, prec), prec)
+		if !BigEqualFloat64(result, 1.0) || !info.Converged || info.Iterations != 0 {
+			t.Errorf("BigExpWithInfo(0) = %s, %+v; want 1 with no iterations", result.Text('g', 10), info)
+		}
+	})
+
+	t.Run("iteration_limit", func(t *testing.T) {
+		_, info := BigExpWithLimit(NewBigFloat(1.0, prec), prec, 2)
+		if info.Converged {
+			t.Error("BigExpWithLimit(1, 2 terms) reported Converged = true")
+		}
+		if info.Iterations != 2 {
+			t.Errorf("BigExpWithLimit(1, 2 terms) performed %d iterations, want 2", info.Iterations)
+		}
+		if info.FinalRelError.Cmp(tolerance) <= 0 {
+			t.Errorf("BigExpWithLimit(1, 2 terms) final relative error = %s, want large", info.FinalRelError.Text('g', 10))
+		}
+	})
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+// SphericalToCartesian converts spherical coordinates to a Cartesian vector
+// theta is the polar angle from the +z axis and phi the azimuth from the +x
+// axis towards +y, both in radians:
+//
+//	x = r sin θ cos φ, y = r sin θ sin φ, z = r cos θ
+func SphericalToCartesian(r, theta, phi *BigFloat, prec uint) *BigVec3 {
+	if prec == 0 {
+		prec = r.Prec()
+	}
+	workPrec := prec + 32
+
+	sinTheta, cosTheta := BigSinCos(theta, workPrec)
+	sinPhi, cosPhi := BigSinCos(phi, workPrec)
+	rSinTheta := new(BigFloat).SetPrec(workPrec).Mul(r, sinTheta)
+
+	return roundBigVec3(&BigVec3{
+		X: new(BigFloat).SetPrec(workPrec).Mul(rSinTheta, cosPhi),
+		Y: new(BigFloat).SetPrec(workPrec).Mul(rSinTheta, sinPhi),
+		Z: new(BigFloat).SetPrec(workPrec).Mul(r, cosTheta),
+	}, prec)
+}
+
+// CartesianToSpherical converts a Cartesian vector to spherical coordinates
+// using the conventions of SphericalToCartesian, with theta in [0, π] and phi
+// in (-π, π]. Both angles come from atan2, which stays accurate near the poles
+// and the equator where acos or asin would lose digits. On the z axis phi is
+// 0, and at the origin all three coordinates are 0.
+func CartesianToSpherical(v *BigVec3, prec uint) (r, theta, phi *BigFloat) {
+	if prec == 0 {
+		prec = v.X.Prec()
+	}
+	workPrec := prec + 32
+
+	// rho = √(x² + y²) is the distance from the z axis
+	rho := new(BigFloat).SetPrec(workPrec).Mul(v.X, v.X)
+	rho.Add(rho, new(BigFloat).SetPrec(workPrec).Mul(v.Y, v.Y))
+	r = new(BigFloat).SetPrec(workPrec).Mul(v.Z, v.Z)
+	r.Add(r, rho)
+	r.Sqrt(r)
+	rho.Sqrt(rho)
+
+	if r.Sign() == 0 {
+		return NewBigFloat(0.0, prec), NewBigFloat(0.0, prec), NewBigFloat(0.0, prec)
+	}
+
+	theta = BigAtan2(rho, v.Z, prec)
+	if rho.Sign() == 0 {
+		phi = NewBigFloat(0.0, prec)
+	} else {
+		phi = BigAtan2(v.Y, v.X, prec)
+	}
+	return new(BigFloat).SetPrec(prec).Set(r), theta, phi
+}
+
+// ellipsoidEccentricitySq returns e² = f(2 - f) for the flattening f
+func ellipsoidEccentricitySq(f *BigFloat, workPrec uint) *BigFloat {
+	e2 := new(BigFloat).SetPrec(workPrec).Sub(NewBigFloat(2.0, workPrec), f)
+	return e2.Mul(e2, f)
+}
+
+// ellipsoidW returns √(1 - e² sin² lat), so that the prime vertical radius
+// of curvature is N = a/w
+func ellipsoidW(e2, sinLat *BigFloat, workPrec uint) *BigFloat {
+	w := new(BigFloat).SetPrec(workPrec).Mul(sinLat, sinLat)
+	w.Mul(w, e2)
+	w.Sub(NewBigFloat(1.0, workPrec), w)
+	return w.Sqrt(w)
+}
+
+// GeodeticToCartesian converts geodetic latitude and longitude (radians) and
+// ellipsoidal height to geocentric Cartesian coordinates on the ellipsoid with
+// semi-major axis a and flattening f:
+//
+//	N = a/√(1 - e² sin² lat), e² = f(2 - f)
+//	x = (N + h) cos lat cos lon, y = (N + h) cos lat sin lon
+//	z = (N(1 - e²) + h) sin lat
+//
+// The result is in the units of a and height.
+func GeodeticToCartesian(lat, lon, height, a, f *BigFloat, prec uint) *BigVec3 {
+	if prec == 0 {
+		prec = a.Prec()
+	}
+	workPrec := prec + 32
+
+	e2 := ellipsoidEccentricitySq(f, workPrec)
+	sinLat, cosLat := BigSinCos(lat, workPrec)
+	sinLon, cosLon := BigSinCos(lon, workPrec)
+
+	mul := func(x, y *BigFloat) *BigFloat { return new(BigFloat).SetPrec(workPrec).Mul(x, y) }
+
+	n := new(BigFloat).SetPrec(workPrec).Quo(a, ellipsoidW(e2, sinLat, workPrec))
+
+	nh := new(BigFloat).SetPrec(workPrec).Add(n, height)
+	nz := mul(n, new(BigFloat).SetPrec(workPrec).Sub(NewBigFloat(1.0, workPrec), e2))
+	nz.Add(nz, height)
+
+	return roundBigVec3(&BigVec3{
+		X: mul(mul(nh, cosLat), cosLon),
+		Y: mul(mul(nh, cosLat), sinLon),
+		Z: mul(nz, sinLat),
+	}, prec)
+}
+
+// CartesianToGeodetic converts geocentric Cartesian coordinates to geodetic
+// latitude and longitude (radians) and ellipsoidal height on the ellipsoid
+// with semi-major axis a and flattening f. Latitude is found by the
+// fixed-point iteration lat ← atan2(z + e²N sin lat, p), p = √(x² + y²),
+// which gains about -log2(e²) bits per step, and the height by
+//
+//	h = p cos lat + z sin lat - a√(1 - e² sin² lat)
+//
+// which stays accurate at the poles. On the polar axis the longitude is 0.
+func CartesianToGeodetic(v *BigVec3, a, f *BigFloat, prec uint) (lat, lon, height *BigFloat) {
+	if prec == 0 {
+		prec = a.Prec()
+	}
+	workPrec := prec + 32
+
+	mul := func(x, y *BigFloat) *BigFloat { return new(BigFloat).SetPrec(workPrec).Mul(x, y) }
+	one := NewBigFloat(1.0, workPrec)
+
+	e2 := ellipsoidEccentricitySq(f, workPrec)
+	p := mul(v.X, v.X)
+	p.Add(p, mul(v.Y, v.Y))
+	p.Sqrt(p)
+
+	if p.Sign() == 0 {
+		lon = NewBigFloat(0.0, prec)
+	} else {
+		lon = BigAtan2(v.Y, v.X, prec)
+	}
+
+	// tan lat = z/(p(1 - e²)) is exact on the surface and a close start elsewhere
+	lat = BigAtan2(v.Z, mul(p, new(BigFloat).SetPrec(workPrec).Sub(one, e2)), workPrec)
+	for i := 0; i < solverMaxIterations; i++ {
+		// z + e²N sin lat
+		sinLat := BigSin(lat, workPrec)
+		num := mul(e2, mul(a, sinLat))
+		num.Quo(num, ellipsoidW(e2, sinLat, workPrec))
+		num.Add(num, v.Z)
+
+		next := BigAtan2(num, p, workPrec)
+		step := new(BigFloat).SetPrec(workPrec).Sub(next, lat)
+		lat = next
+		if solverConverged(step, lat, prec) {
+			break
+		}
+	}
+
+	sinLat, cosLat := BigSinCos(lat, workPrec)
+	height = mul(p, cosLat)
+	height.Add(height, mul(v.Z, sinLat))
+	height.Sub(height, mul(a, ellipsoidW(e2, sinLat, workPrec)))
+
+	return new(BigFloat).SetPrec(prec).Set(lat), lon, new(BigFloat).SetPrec(prec).Set(height)
+}
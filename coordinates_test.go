@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"testing"
+)
+
+func TestSphericalCartesian(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+
+	pi := BigPI(prec)
+	halfPi := BigHalfPI(prec)
+	zero := NewBigFloat(0.0, prec)
+	one := NewBigFloat(1.0, prec)
+
+	basis := []struct {
+		name       string
+		v          *BigVec3
+		theta, phi *BigFloat
+	}{
+		{"+x", NewBigVec3(1, 0, 0, prec), halfPi, zero},
+		{"+y", NewBigVec3(0, 1, 0, prec), halfPi, halfPi},
+		{"+z", NewBigVec3(0, 0, 1, prec), zero, zero},
+		{"-x", NewBigVec3(-1, 0, 0, prec), halfPi, pi},
+		{"-y", NewBigVec3(0, -1, 0, prec), halfPi, new(BigFloat).SetPrec(prec).Neg(halfPi)},
+		{"-z", NewBigVec3(0, 0, -1, prec), pi, zero},
+	}
+
+	for _, tt := range basis {
+		t.Run("to_spherical_"+tt.name, func(t *testing.T) {
+			r, theta, phi := CartesianToSpherical(tt.v, prec)
+			if !BigFloatEqual(r, one, tol) || !BigFloatEqual(theta, tt.theta, tol) || !BigFloatEqual(phi, tt.phi, tol) {
+				t.Errorf("CartesianToSpherical(%v) = (%s, %s, %s), want (1, %s, %s)", tt.v.ToFloat64(),
+					r.Text('g', 20), theta.Text('g', 20), phi.Text('g', 20), tt.theta.Text('g', 20), tt.phi.Text('g', 20))
+			}
+		})
+		t.Run("to_cartesian_"+tt.name, func(t *testing.T) {
+			if got := SphericalToCartesian(one, tt.theta, tt.phi, prec); !BigVec3Equal(got, tt.v, tol) {
+				t.Errorf("SphericalToCartesian(1, %s, %s) = %v, want %v", tt.theta.Text('g', 20), tt.phi.Text('g', 20), got.ToFloat64(), tt.v.ToFloat64())
+			}
+		})
+	}
+
+	t.Run("origin", func(t *testing.T) {
+		r, theta, phi := CartesianToSpherical(NewBigVec3(0, 0, 0, prec), prec)
+		if r.Sign() != 0 || theta.Sign() != 0 || phi.Sign() != 0 {
+			t.Errorf("CartesianToSpherical(0) = (%s, %s, %s), want (0, 0, 0)", r.Text('g', 10), theta.Text('g', 10), phi.Text('g', 10))
+		}
+	})
+
+	t.Run("round_trip", func(t *testing.T) {
+		vectors := []*BigVec3{
+			NewBigVec3(1.5, -2.25, 3.125, prec),
+			NewBigVec3(-7, 0.001, -0.5, prec),
+			NewBigVec3(1e-20, 1e-20, 1, prec),
+			NewBigVec3(123456.789, -98765.4321, 1e-3, prec),
+		}
+		for _, v := range vectors {
+			r, theta, phi := CartesianToSpherical(v, prec)
+			back := SphericalToCartesian(r, theta, phi, prec)
+			if !BigVec3Equal(back, v, tol) {
+				t.Errorf("round trip of %v gave %v", v.ToFloat64(), back.ToFloat64())
+			}
+		}
+	})
+}
+
+func TestGeodeticCartesian(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-30", prec)
+
+	// WGS84: a = 6378137 m, f = 1/298.257223563
+	a := NewBigFloat(6378137.0, prec)
+	invF, _ := NewBigFloatFromString("298.257223563", prec)
+	f := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1.0, prec), invF)
+
+	deg := func(d float64) *BigFloat { return BigRadians(NewBigFloat(d, prec), prec) }
+	meters := func(s string) *BigFloat {
+		v, _ := NewBigFloatFromString(s, prec)
+		return v
+	}
+
+	t.Run("equator_prime_meridian", func(t *testing.T) {
+		got := GeodeticToCartesian(deg(0), deg(0), meters("0"), a, f, prec)
+		if !BigVec3Equal(got, &BigVec3{X: a, Y: NewBigFloat(0, prec), Z: NewBigFloat(0, prec)}, tol) {
+			t.Errorf("GeodeticToCartesian(0, 0, 0) = %v, want (a, 0, 0)", got.ToFloat64())
+		}
+	})
+
+	t.Run("north_pole", func(t *testing.T) {
+		// The pole lies on the semi-minor axis b = a(1 - f)
+		b := new(BigFloat).SetPrec(prec).Sub(NewBigFloat(1.0, prec), f)
+		b.Mul(b, a)
+		got := GeodeticToCartesian(deg(90), deg(0), meters("0"), a, f, prec)
+		if !BigVec3Equal(got, &BigVec3{X: NewBigFloat(0, prec), Y: NewBigFloat(0, prec), Z: b}, tol) {
+			t.Errorf("GeodeticToCartesian(90°, 0, 0) = %v, want (0, 0, b)", got.ToFloat64())
+		}
+	})
+
+	tests := []struct {
+		name     string
+		lat, lon float64
+		height   string
+	}{
+		{"mid_latitude", 45.5, 7.25, "250.125"},
+		{"southern_west", -33.875, -151.2, "-12.5"},
+		{"equator", 0, 179.5, "0"},
+		{"near_pole", 89.999999, 10, "4000"},
+		{"south_pole", -90, 0, "2835"},
+		{"geostationary", 0.001, -75, "35786000"},
+	}
+
+	for _, tt := range tests {
+		t.Run("round_trip_"+tt.name, func(t *testing.T) {
+			lat, lon, h := deg(tt.lat), deg(tt.lon), meters(tt.height)
+			v := GeodeticToCartesian(lat, lon, h, a, f, prec)
+			gotLat, gotLon, gotH := CartesianToGeodetic(v, a, f, prec)
+
+			// Sub-millimetre agreement is the requirement; the iteration
+			// actually reaches the working precision
+			if !BigFloatEqual(gotLat, lat, tol) || !BigFloatEqual(gotLon, lon, tol) || !BigFloatEqual(gotH, h, tol) {
+				t.Errorf("round trip = (%s, %s, %s), want (%s, %s, %s)",
+					gotLat.Text('g', 40), gotLon.Text('g', 40), gotH.Text('g', 40),
+					lat.Text('g', 40), lon.Text('g', 40), h.Text('g', 40))
+			}
+
+			back := GeodeticToCartesian(gotLat, gotLon, gotH, a, f, prec)
+			if !BigVec3Equal(back, v, tol) {
+				t.Errorf("Cartesian round trip = %v, want %v", back.ToFloat64(), v.ToFloat64())
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+// derivativeInitialStepExp is the binary exponent of the first step,
+// h0 = 2^-4 · max(1, |x|)
+const derivativeInitialStepExp = 4
+
+// BigDerivative estimates f'(x) with central differences and Richardson
+// extrapolation (Ridders' method)
+// The steps h, h/2, h/4, ... start at 2^-4·max(1, |x|), and enough halvings
+// are allowed for the extrapolated truncation error to reach 2^-prec. f is
+// called with arguments at a higher working precision and should evaluate at
+// the precision of its argument. Iteration stops once the error estimate
+// reaches 2^-prec relative to the result or starts to grow.
+func BigDerivative(f func(*BigFloat) *BigFloat, x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	// Extrapolating level i cancels the h^(2i) error term, so about √prec
+	// levels suffice. Each halving of h costs one bit to cancellation.
+	maxLevels := 4
+	for maxLevels*maxLevels < int(prec) {
+		maxLevels++
+	}
+	maxLevels += 4
+	workPrec := prec + 32 + uint(maxLevels+derivativeInitialStepExp)
+
+	xw := new(BigFloat).SetPrec(workPrec).Set(x)
+	scale := 0
+	if x.Sign() != 0 && x.MantExp(nil) > 0 {
+		scale = x.MantExp(nil)
+	}
+	h := new(BigFloat).SetPrec(workPrec).SetMantExp(NewBigFloat(1.0, workPrec), scale-derivativeInitialStepExp)
+
+	centralDiff := func(h *BigFloat) *BigFloat {
+		xPlus := new(BigFloat).SetPrec(workPrec).Add(xw, h)
+		xMinus := new(BigFloat).SetPrec(workPrec).Sub(xw, h)
+		d := new(BigFloat).SetPrec(workPrec).Sub(f(xPlus), f(xMinus))
+		// (x+h) - (x-h) is the step actually taken once x±h are rounded
+		return d.Quo(d, xPlus.Sub(xPlus, xMinus))
+	}
+
+	// prev and cur hold successive rows of the Richardson tableau
+	prev := []*BigFloat{centralDiff(h)}
+	best := prev[0]
+	var bestErr *BigFloat
+	diff := new(BigFloat).SetPrec(workPrec)
+
+	for i := 1; i < maxLevels; i++ {
+		h.SetMantExp(h, -1)
+		cur := make([]*BigFloat, i+1)
+		cur[0] = centralDiff(h)
+
+		factor := NewBigFloat(1.0, workPrec)
+		for j := 1; j <= i; j++ {
+			// cur[j] = cur[j-1] + (cur[j-1] - prev[j-1]) / (4^j - 1)
+			factor.SetMantExp(factor, 2)
+			denom := new(BigFloat).SetPrec(workPrec).Sub(factor, NewBigFloat(1.0, workPrec))
+			diff.Sub(cur[j-1], prev[j-1])
+			cur[j] = new(BigFloat).SetPrec(workPrec).Quo(diff, denom)
+			cur[j].Add(cur[j], cur[j-1])
+
+			// The error estimate is the larger change against the two
+			// lower-order values the entry was built from
+			errA := new(BigFloat).SetPrec(workPrec).Sub(cur[j], cur[j-1])
+			errB := new(BigFloat).SetPrec(workPrec).Sub(cur[j], prev[j-1])
+			errA.Abs(errA)
+			errB.Abs(errB)
+			if errB.Cmp(errA) > 0 {
+				errA = errB
+			}
+			if bestErr == nil || errA.Cmp(bestErr) <= 0 {
+				best, bestErr = cur[j], errA
+			}
+		}
+
+		if solverConverged(bestErr, best, prec) {
+			break
+		}
+
+		// Once the highest-order entry moves more than twice the best error
+		// estimate, rounding error dominates and further levels only hurt
+		diff.Sub(cur[i], prev[i-1])
+		if diff.Abs(diff).Cmp(new(BigFloat).SetPrec(workPrec).SetMantExp(bestErr, 1)) > 0 {
+			break
+		}
+		prev = cur
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(best)
+}
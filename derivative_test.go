@@ -0,0 +1,49 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBigDerivative(t *testing.T) {
+	cube := func(x *BigFloat) *BigFloat {
+		r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+		return r.Mul(r, x)
+	}
+	sin := func(x *BigFloat) *BigFloat { return BigSin(x, x.Prec()) }
+	exp := func(x *BigFloat) *BigFloat { return BigExp(x, x.Prec()) }
+
+	for _, prec := range []uint{128, 256} {
+		tolStr := "1e-25"
+		if prec == 256 {
+			tolStr = "1e-60"
+		}
+		tol, _ := NewBigFloatFromString(tolStr, prec)
+
+		third := new(BigFloat).SetPrec(prec).Quo(BigPI(prec), NewBigFloat(3.0, prec))
+		tests := []struct {
+			name string
+			f    func(*BigFloat) *BigFloat
+			x    *BigFloat
+			want *BigFloat
+		}{
+			// A float64 step of ~1e-5 would limit these to about 10 digits
+			{"sin_at_pi_over_3", sin, third, NewBigFloat(0.5, prec)},
+			{"cube_at_2", cube, NewBigFloat(2.0, prec), NewBigFloat(12.0, prec)},
+			{"cube_at_0", cube, NewBigFloat(0.0, prec), NewBigFloat(0.0, prec)},
+			{"exp_at_10", exp, NewBigFloat(10.0, prec), BigExp(NewBigFloat(10.0, prec), prec)},
+		}
+
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s/prec_%d", tt.name, prec), func(t *testing.T) {
+				got := BigDerivative(tt.f, tt.x, prec)
+				if !BigFloatEqual(got, tt.want, tol) {
+					t.Errorf("derivative = %s, want %s within %s", got.Text('g', 50), tt.want.Text('g', 50), tolStr)
+				}
+			})
+		}
+	}
+}
@@ -85,6 +85,8 @@ type (
 	bigMatMulMatFunc    func(m1, m2 *BigMatrix3x3, prec uint) *BigMatrix3x3
 	bigMatDetFunc       func(m *BigMatrix3x3, prec uint) *BigFloat
 	bigMatInverseFunc   func(m *BigMatrix3x3, prec uint) (*BigMatrix3x3, error)
+	bigMatAddFunc       func(a, b *BigMatrix3x3, prec uint) *BigMatrix3x3
+	bigMatSubFunc       func(a, b *BigMatrix3x3, prec uint) *BigMatrix3x3
 )
 
 // Dispatcher holds function pointers selected at runtime
@@ -165,6 +167,8 @@ type Dispatcher struct {
 	BigMatMulMatImpl    bigMatMulMatFunc
 	BigMatDetImpl       bigMatDetFunc
 	BigMatInverseImpl   bigMatInverseFunc
+	BigMatAddImpl       bigMatAddFunc
+	BigMatSubImpl       bigMatSubFunc
 
 	// CPU features used
 	Features CPUFeatures
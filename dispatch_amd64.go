@@ -74,6 +74,8 @@ func initDispatcherImpl(d *Dispatcher) {
 		d.BigMatMulMatImpl = bigMatMulMatAsm
 		d.BigMatDetImpl = bigMatDetAsm
 		d.BigMatInverseImpl = bigMatInverseGeneric // No asm for error-returning function yet
+		d.BigMatAddImpl = bigMatAddGeneric         // Element-wise, nothing to gain from asm
+		d.BigMatSubImpl = bigMatSubGeneric
 	} else {
 		// Fallback to standard AMD64 assembly
 		d.BigVec3AddImpl = bigVec3AddAMD64
@@ -140,5 +142,7 @@ func initDispatcherImpl(d *Dispatcher) {
 		d.BigMatMulMatImpl = bigMatMulMatAsm
 		d.BigMatDetImpl = bigMatDetAsm
 		d.BigMatInverseImpl = bigMatInverseGeneric // No asm for error-returning function yet
+		d.BigMatAddImpl = bigMatAddGeneric         // Element-wise, nothing to gain from asm
+		d.BigMatSubImpl = bigMatSubGeneric
 	}
 }
@@ -76,4 +76,6 @@ func initDispatcherImpl(d *Dispatcher) {
 	d.BigMatMulMatImpl = bigMatMulMatAsm
 	d.BigMatDetImpl = bigMatDetAsm
 	d.BigMatInverseImpl = bigMatInverseGeneric // No asm for error-returning function yet
+	d.BigMatAddImpl = bigMatAddGeneric         // Element-wise, nothing to gain from asm
+	d.BigMatSubImpl = bigMatSubGeneric
 }
@@ -70,4 +70,6 @@ func initDispatcherImpl(d *Dispatcher) {
 	d.BigMatMulMatImpl = bigMatMulMatGeneric
 	d.BigMatDetImpl = bigMatDetGeneric
 	d.BigMatInverseImpl = bigMatInverseGeneric
+	d.BigMatAddImpl = bigMatAddGeneric
+	d.BigMatSubImpl = bigMatSubGeneric
 }
@@ -0,0 +1,182 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import "fmt"
+
+// RotationOrder selects the axis sequence of a three-angle rotation
+// The six Tait-Bryan orders use three distinct axes and the six proper Euler
+// orders repeat the first axis. In every order the rotation about the first
+// axis by angles[0] is applied first, about fixed (extrinsic) axes, so the
+// composite matrix is R = R3(angles[2])·R2(angles[1])·R1(angles[0]).
+// Read right to left this is the same as rotating about the moving
+// (intrinsic) axes in the reverse order.
+type RotationOrder int
+
+// Tait-Bryan and proper Euler rotation orders
+const (
+	RotationXYZ RotationOrder = iota
+	RotationXZY
+	RotationYXZ
+	RotationYZX
+	RotationZXY
+	RotationZYX
+	RotationXYX
+	RotationXZX
+	RotationYXY
+	RotationYZY
+	RotationZXZ
+	RotationZYZ
+)
+
+// rotationOrderAxes lists the axes (0 = x, 1 = y, 2 = z) of each order in the
+// sequence they are applied
+var rotationOrderAxes = [...][3]int{
+	RotationXYZ: {0, 1, 2},
+	RotationXZY: {0, 2, 1},
+	RotationYXZ: {1, 0, 2},
+	RotationYZX: {1, 2, 0},
+	RotationZXY: {2, 0, 1},
+	RotationZYX: {2, 1, 0},
+	RotationXYX: {0, 1, 0},
+	RotationXZX: {0, 2, 0},
+	RotationYXY: {1, 0, 1},
+	RotationYZY: {1, 2, 1},
+	RotationZXZ: {2, 0, 2},
+	RotationZYZ: {2, 1, 2},
+}
+
+// axes returns the axis sequence of o, or false if o is not a valid order
+func (o RotationOrder) axes() ([3]int, bool) {
+	if o < 0 || int(o) >= len(rotationOrderAxes) {
+		return [3]int{}, false
+	}
+	return rotationOrderAxes[o], true
+}
+
+// String returns the axis sequence, e.g. "ZYX"
+func (o RotationOrder) String() string {
+	axes, ok := o.axes()
+	if !ok {
+		return fmt.Sprintf("RotationOrder(%d)", int(o))
+	}
+	const names = "XYZ"
+	return string([]byte{names[axes[0]], names[axes[1]], names[axes[2]]})
+}
+
+// bigAxisRotation returns the right-handed rotation by angle about the
+// coordinate axis (0 = x, 1 = y, 2 = z), with the same sign convention as
+// CreateRotationMatrix
+func bigAxisRotation(axis int, angle *BigFloat, prec uint) *BigMatrix3x3 {
+	s, c := BigSinCos(angle, prec)
+	m := NewIdentityMatrix(prec)
+
+	// i and j span the plane of rotation, in right-handed order
+	i, j := (axis+1)%3, (axis+2)%3
+	m.M[i][i].Set(c)
+	m.M[i][j].Neg(s)
+	m.M[j][i].Set(s)
+	m.M[j][j].Set(c)
+	return m
+}
+
+// CreateRotationMatrixOrder creates the rotation matrix for three angles
+// (radians) applied about the axes of order, angles[0] first (see
+// RotationOrder). An order that is not one of the RotationOrder constants
+// returns the identity matrix; ExtractEulerAngles reports it as an error.
+func CreateRotationMatrixOrder(angles [3]*BigFloat, order RotationOrder, prec uint) *BigMatrix3x3 {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+	axes, ok := order.axes()
+	if !ok {
+		return NewIdentityMatrix(prec)
+	}
+
+	workPrec := prec + 32
+	m := bigAxisRotation(axes[0], angles[0], workPrec)
+	for k := 1; k < 3; k++ {
+		m = BigMatMulMat(bigAxisRotation(axes[k], angles[k], workPrec), m, workPrec)
+	}
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m.M[i][j] = new(BigFloat).SetPrec(prec).Set(m.M[i][j])
+		}
+	}
+	return m
+}
+
+// ExtractEulerAngles recovers the angles of the rotation m in the given order,
+// so that CreateRotationMatrixOrder(angles, order, prec) reproduces m
+// For the Tait-Bryan orders angles[1] is in [-π/2, π/2] and for the proper
+// Euler orders in [0, π]; angles[0] and angles[2] are in (-π, π]. All three
+// come from atan2, which stays accurate near the singular middle angles.
+// In gimbal lock (cos angles[1] = 0 for Tait-Bryan, sin angles[1] = 0 for
+// proper Euler orders) only the sum or difference of the outer angles is
+// determined; angles[0] is then set to 0 and angles[2] carries the whole
+// rotation. The lock is detected when the relevant term falls below
+// 2^(-prec/2), where the outer angles would lose half their digits.
+// m is assumed to be a rotation matrix. Returns an error for an invalid order.
+func ExtractEulerAngles(m *BigMatrix3x3, order RotationOrder, prec uint) ([3]*BigFloat, error) {
+	if prec == 0 {
+		prec = m.M[0][0].Prec()
+	}
+	axes, ok := order.axes()
+	if !ok {
+		return [3]*BigFloat{}, fmt.Errorf("invalid rotation order %d", int(order))
+	}
+	workPrec := prec + 32
+
+	// i and j are the first two axes. k is the remaining one, which is the
+	// last axis of a Tait-Bryan order. sign is +1 if (i, j, k) is cyclic.
+	i, j := axes[0], axes[1]
+	k := 3 - i - j
+	neg := func(x *BigFloat) *BigFloat { return new(BigFloat).SetPrec(workPrec).Neg(x) }
+	pos := func(x *BigFloat) *BigFloat { return new(BigFloat).SetPrec(workPrec).Set(x) }
+	signed := pos
+	if (j-i+3)%3 != 1 {
+		signed = neg
+	}
+
+	hypot := func(x, y *BigFloat) *BigFloat {
+		h := new(BigFloat).SetPrec(workPrec).Mul(x, x)
+		h.Add(h, new(BigFloat).SetPrec(workPrec).Mul(y, y))
+		return h.Sqrt(h)
+	}
+	lockTol := new(BigFloat).SetPrec(workPrec).SetMantExp(NewBigFloat(1.0, workPrec), -int(prec/2))
+
+	var angles [3]*BigFloat
+	if axes[2] == i {
+		// Proper Euler: R = Ri(c)·Rj(b)·Ri(a) with
+		// R[i][i] = cos b, R[i][j] = sin b sin a, R[i][k] = ±sin b cos a,
+		// R[j][i] = sin b sin c, R[k][i] = ∓sin b cos c
+		sinB := hypot(m.M[i][j], m.M[i][k])
+		angles[1] = BigAtan2(sinB, m.M[i][i], prec)
+		if sinB.Cmp(lockTol) > 0 {
+			angles[0] = BigAtan2(m.M[i][j], signed(m.M[i][k]), prec)
+			angles[2] = BigAtan2(m.M[j][i], neg(signed(m.M[k][i])), prec)
+		} else {
+			// With a = 0 the j axis is only turned by Ri(c)
+			angles[0] = NewBigFloat(0.0, prec)
+			angles[2] = BigAtan2(signed(m.M[k][j]), m.M[j][j], prec)
+		}
+		return angles, nil
+	}
+
+	// Tait-Bryan: R = Rk(c)·Rj(b)·Ri(a) with
+	// R[k][i] = ∓sin b, R[k][j] = ±cos b sin a, R[k][k] = cos b cos a,
+	// R[j][i] = ±cos b sin c, R[i][i] = cos b cos c
+	cosB := hypot(m.M[i][i], m.M[j][i])
+	angles[1] = BigAtan2(neg(signed(m.M[k][i])), cosB, prec)
+	if cosB.Cmp(lockTol) > 0 {
+		angles[0] = BigAtan2(signed(m.M[k][j]), m.M[k][k], prec)
+		angles[2] = BigAtan2(signed(m.M[j][i]), m.M[i][i], prec)
+	} else {
+		// With a = 0 the j axis is only turned by Rk(c)
+		angles[0] = NewBigFloat(0.0, prec)
+		angles[2] = BigAtan2(neg(signed(m.M[i][j])), m.M[j][j], prec)
+	}
+	return angles, nil
+}
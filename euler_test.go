@@ -0,0 +1,171 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"fmt"
+	"testing"
+)
+
+// matrix3x3Equal reports whether a and b agree element-wise within tol
+func matrix3x3Equal(a, b *BigMatrix3x3, tol *BigFloat) bool {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if !BigFloatEqual(a.M[i][j], b.M[i][j], tol) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// elementaryRotation writes out Rx, Ry or Rz explicitly
+func elementaryRotation(axis byte, angle *BigFloat, prec uint) *BigMatrix3x3 {
+	s, c := BigSinCos(angle, prec)
+	ns := new(BigFloat).Neg(s)
+	zero, one := NewBigFloat(0, prec), NewBigFloat(1, prec)
+
+	var rows [3][3]*BigFloat
+	switch axis {
+	case 'X':
+		rows = [3][3]*BigFloat{{one, zero, zero}, {zero, c, ns}, {zero, s, c}}
+	case 'Y':
+		rows = [3][3]*BigFloat{{c, zero, s}, {zero, one, zero}, {ns, zero, c}}
+	case 'Z':
+		rows = [3][3]*BigFloat{{c, ns, zero}, {s, c, zero}, {zero, zero, one}}
+	}
+	return &BigMatrix3x3{M: rows}
+}
+
+func TestCreateRotationMatrixOrder(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	angles := [3]*BigFloat{NewBigFloat(0.3, prec), NewBigFloat(-1.1, prec), NewBigFloat(2.4, prec)}
+	orders := []RotationOrder{
+		RotationXYZ, RotationXZY, RotationYXZ, RotationYZX, RotationZXY, RotationZYX,
+		RotationXYX, RotationXZX, RotationYXY, RotationYZY, RotationZXZ, RotationZYZ,
+	}
+
+	for _, order := range orders {
+		t.Run(order.String(), func(t *testing.T) {
+			name := order.String()
+			// angles[0] is applied first: R = R3(a2)·R2(a1)·R1(a0)
+			want := BigMatMulMat(
+				elementaryRotation(name[2], angles[2], prec),
+				BigMatMulMat(elementaryRotation(name[1], angles[1], prec), elementaryRotation(name[0], angles[0], prec), prec),
+				prec,
+			)
+			got := CreateRotationMatrixOrder(angles, order, prec)
+			if !matrix3x3Equal(got, want, tol) {
+				t.Errorf("CreateRotationMatrixOrder(%s) does not match R3·R2·R1", name)
+			}
+			if err := ValidateRotationMatrix(got, tol, prec); err != nil {
+				t.Errorf("CreateRotationMatrixOrder(%s) is not a rotation: %v", name, err)
+			}
+		})
+	}
+
+	t.Run("default_is_z_rotation", func(t *testing.T) {
+		zero := NewBigFloat(0, prec)
+		got := CreateRotationMatrixOrder([3]*BigFloat{angles[0], zero, zero}, RotationZYX, prec)
+		if !matrix3x3Equal(got, CreateRotationMatrix(angles, prec), tol) {
+			t.Error("CreateRotationMatrix does not match RotationZYX with only the first angle")
+		}
+	})
+
+	t.Run("vector_sequence", func(t *testing.T) {
+		// +x rotated 90° about z and then 90° about x lands on +z
+		half := BigHalfPI(prec)
+		m := CreateRotationMatrixOrder([3]*BigFloat{half, half, NewBigFloat(0, prec)}, RotationZXY, prec)
+		if got := BigMatMul(m, NewBigVec3(1, 0, 0, prec), prec); !BigVec3Equal(got, NewBigVec3(0, 0, 1, prec), tol) {
+			t.Errorf("ZXY(90°, 90°, 0) applied to +x = %v, want (0, 0, 1)", got.ToFloat64())
+		}
+	})
+
+	t.Run("invalid_order_is_identity", func(t *testing.T) {
+		for _, order := range []RotationOrder{-1, 12, 42} {
+			if got := CreateRotationMatrixOrder(angles, order, prec); !matrix3x3Equal(got, NewIdentityMatrix(prec), tol) {
+				t.Errorf("CreateRotationMatrixOrder with invalid order %d is not the identity", int(order))
+			}
+		}
+	})
+
+	if s := RotationOrder(-1).String(); s != "RotationOrder(-1)" {
+		t.Errorf("RotationOrder(-1).String() = %q", s)
+	}
+}
+
+func TestExtractEulerAngles(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-35", prec)
+	orders := []RotationOrder{
+		RotationXYZ, RotationXZY, RotationYXZ, RotationYZX, RotationZXY, RotationZYX,
+		RotationXYX, RotationXZX, RotationYXY, RotationYZY, RotationZXZ, RotationZYZ,
+	}
+	angleSets := [][3]float64{
+		{0.3, -1.1, 2.4},
+		{-2.9, 0.2, -0.7},
+		{1.5, 1.3, 3.0},
+	}
+
+	for _, order := range orders {
+		for n, set := range angleSets {
+			t.Run(fmt.Sprintf("%s/%d", order, n), func(t *testing.T) {
+				angles := [3]*BigFloat{NewBigFloat(set[0], prec), NewBigFloat(set[1], prec), NewBigFloat(set[2], prec)}
+				if order >= RotationXYX {
+					// Proper Euler middle angles are in [0, π]
+					angles[1].Abs(angles[1])
+				}
+				m := CreateRotationMatrixOrder(angles, order, prec)
+
+				got, err := ExtractEulerAngles(m, order, prec)
+				if err != nil {
+					t.Fatalf("ExtractEulerAngles(%s) error = %v", order, err)
+				}
+				for a := range angles {
+					if !BigFloatEqual(got[a], angles[a], tol) {
+						t.Errorf("angles[%d] = %s, want %s", a, got[a].Text('g', 20), angles[a].Text('g', 20))
+					}
+				}
+				if rebuilt := CreateRotationMatrixOrder(got, order, prec); !matrix3x3Equal(rebuilt, m, tol) {
+					t.Error("rebuilt matrix does not match the original")
+				}
+			})
+		}
+	}
+
+	t.Run("gimbal_lock", func(t *testing.T) {
+		zero := NewBigFloat(0, prec)
+		for _, tc := range []struct {
+			order  RotationOrder
+			middle *BigFloat
+		}{
+			{RotationXYZ, BigHalfPI(prec)},
+			{RotationZYX, new(BigFloat).Neg(BigHalfPI(prec))},
+			{RotationZXZ, zero},
+			{RotationYZY, BigPI(prec)},
+		} {
+			angles := [3]*BigFloat{NewBigFloat(0.4, prec), tc.middle, NewBigFloat(-1.2, prec)}
+			m := CreateRotationMatrixOrder(angles, tc.order, prec)
+
+			got, err := ExtractEulerAngles(m, tc.order, prec)
+			if err != nil {
+				t.Fatalf("ExtractEulerAngles(%s) error = %v", tc.order, err)
+			}
+			if got[0].Sign() != 0 {
+				t.Errorf("%s: angles[0] = %s, want 0 in gimbal lock", tc.order, got[0].Text('g', 20))
+			}
+			if rebuilt := CreateRotationMatrixOrder(got, tc.order, prec); !matrix3x3Equal(rebuilt, m, tol) {
+				t.Errorf("%s: rebuilt matrix does not match the original", tc.order)
+			}
+		}
+	})
+
+	t.Run("invalid_order", func(t *testing.T) {
+		if _, err := ExtractEulerAngles(NewIdentityMatrix(prec), RotationOrder(12), prec); err == nil {
+			t.Error("expected an error for an invalid order")
+		}
+	})
+}
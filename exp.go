@@ -20,23 +20,44 @@ func BigExp(x *BigFloat, prec uint) *BigFloat {
 	return getDispatcher().BigExpImpl(x, prec)
 }
 
+// BigExpRounded computes e^x and rounds the result according to the mode
+func BigExpRounded(x *BigFloat, prec uint, mode RoundingMode) (result *BigFloat, ternary int) {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	workPrec := prec + 32
+	res := BigExp(x, workPrec)
+	return Round(res, prec, mode)
+}
+
+// expMaxIterations bounds the number of Taylor series terms in bigExpGeneric
+const expMaxIterations = 1000
+
 // bigExpGeneric is the generic implementation (called by dispatcher)
 //
 //nolint:unused // Used in dispatch system
 func bigExpGeneric(x *BigFloat, prec uint) *BigFloat {
+	res, _ := bigExpWithInfo(x, prec, expMaxIterations)
+	return res
+}
+
+// bigExpWithInfo computes e^x like bigExpGeneric, summing at most maxIterations
+// Taylor series terms, and reports how the series converged
+func bigExpWithInfo(x *BigFloat, prec uint, maxIterations int) (*BigFloat, ConvergenceInfo) {
 	if prec == 0 {
 		prec = x.Prec()
 	}
 
 	// Handle special cases
+	exact := ConvergenceInfo{Converged: true, FinalRelError: NewBigFloat(0.0, prec)}
 	if x.IsInf() {
 		if x.Sign() > 0 {
-			return new(BigFloat).SetPrec(prec).SetInf(false)
+			return new(BigFloat).SetPrec(prec).SetInf(false), exact
 		}
-		return new(BigFloat).SetPrec(prec).SetFloat64(0.0)
+		return new(BigFloat).SetPrec(prec).SetFloat64(0.0), exact
 	}
 	if x.Sign() == 0 {
-		return NewBigFloat(1.0, prec)
+		return NewBigFloat(1.0, prec), exact
 	}
 
 	// Working precision
@@ -44,7 +65,7 @@ func bigExpGeneric(x *BigFloat, prec uint) *BigFloat {
 
 	// 1. Argument reduction: x = k*ln(2) + r
 	// k = round(x / ln(2))
-	ln2 := BigLog2(workPrec)
+	ln2 := BigLn2(workPrec)
 
 	kFloat := new(BigFloat).SetPrec(workPrec).Quo(x, ln2)
 	kInt := new(big.Int)
@@ -105,17 +126,24 @@ func bigExpGeneric(x *BigFloat, prec uint) *BigFloat {
 	// Threshold for convergence
 	threshold := new(BigFloat).SetPrec(workPrec).SetMantExp(NewBigFloat(1.0, workPrec), -int(workPrec))
 
-	for n := 1; n < 1000; n++ {
+	info := ConvergenceInfo{}
+	for n := 1; n <= maxIterations; n++ {
 		term.Mul(term, rReduced)
 		term.Quo(term, NewBigFloat(float64(n), workPrec))
 
 		res.Add(res, term)
+		info.Iterations = n
 
 		if new(BigFloat).SetPrec(workPrec).Abs(term).Cmp(threshold) < 0 {
+			info.Converged = true
 			break
 		}
 	}
 
+	// The last term added bounds the relative truncation error of exp(rReduced)
+	info.FinalRelError = new(BigFloat).SetPrec(prec).Quo(term, res)
+	info.FinalRelError.Abs(info.FinalRelError)
+
 	// 4. Square S times: res = res^(2^S)
 	for i := 0; i < S; i++ {
 		res.Mul(res, res)
@@ -134,5 +162,5 @@ func bigExpGeneric(x *BigFloat, prec uint) *BigFloat {
 		res.SetMantExp(mant, exp+int(kVal))
 	}
 
-	return new(BigFloat).SetPrec(prec).Set(res)
+	return new(BigFloat).SetPrec(prec).Set(res), info
 }
@@ -36,7 +36,7 @@ func getExpWorkspace(prec uint) *expWorkspace {
 		threshold: NewBigFloat(0.0, workPrec),
 		scale:     NewBigFloat(0.0, workPrec),
 		temp:      NewBigFloat(0.0, workPrec),
-		ln2:       BigLog2(workPrec),
+		ln2:       BigLn2(workPrec),
 		kBig:      NewBigFloat(0.0, workPrec),
 		prec:      prec,
 	}
@@ -174,31 +174,29 @@ func bigLogOptimized(x *BigFloat, prec uint) *BigFloat {
 	exp := x.MantExp(mant)
 
 	// x = mant * 2^exp, so ln(x) = ln(mant) + exp*ln(2)
-	// Normalize mant to [1, 2)
-	if mant.Cmp(NewBigFloat(1.0, workPrec)) < 0 {
+	// Normalize mant to [0.75, 1.5) so that u = (mant-1)/(mant+1) has |u| <= 1/5
+	if mant.Cmp(NewBigFloat(0.75, workPrec)) < 0 {
 		mant.Mul(mant, NewBigFloat(2.0, workPrec))
 		exp--
 	}
 
-	// Now mant is in [1, 2), compute ln(mant) using Taylor series
-	// ln(1+y) = y - y²/2 + y³/3 - ... where y = mant - 1
-	ws.xReduced.Sub(mant, NewBigFloat(1.0, workPrec)) // y = mant - 1
+	// ln(mant) = 2*atanh(u) = 2 * sum u^(2n+1) / (2n+1)
+	// Each term gains at least log2(25) ≈ 4.6 bits
+	one := NewBigFloat(1.0, workPrec)
+	ws.xReduced.Sub(mant, one)
+	ws.temp.Add(mant, one)
+	ws.xReduced.Quo(ws.xReduced, ws.temp) // u
+	u2 := new(BigFloat).SetPrec(workPrec).Mul(ws.xReduced, ws.xReduced)
 
-	// Taylor series: ln(1+y) = sum (-1)^(n+1) * y^n / n
-	ws.result.Set(ws.xReduced) // First term is y
+	ws.result.Set(ws.xReduced) // First term is u
 	ws.term.Set(ws.xReduced)
 
-	ws.threshold.SetMantExp(NewBigFloat(1.0, workPrec), -int(prec))
+	ws.threshold.SetMantExp(NewBigFloat(1.0, workPrec), -int(workPrec))
 
-	for n := 2; n < 1000; n++ {
-		ws.term.Mul(ws.term, ws.xReduced)
+	for n := 1; n < 1000; n++ {
+		ws.term.Mul(ws.term, u2)
 
-		// Add term with alternating sign
-		ws.temp.Set(ws.term)
-		ws.temp.Quo(ws.temp, NewBigFloat(float64(n), workPrec))
-		if n%2 == 0 {
-			ws.temp.Neg(ws.temp)
-		}
+		ws.temp.Quo(ws.term, NewBigFloat(float64(2*n+1), workPrec))
 		ws.result.Add(ws.result, ws.temp)
 
 		// Check convergence
@@ -207,10 +205,11 @@ func bigLogOptimized(x *BigFloat, prec uint) *BigFloat {
 			break
 		}
 	}
+	ws.result.Mul(ws.result, NewBigFloat(2.0, workPrec))
 
 	// Add exp*ln(2)
 	if exp != 0 {
-		ln2 := BigLog2(workPrec)
+		ln2 := BigLn2(workPrec)
 		expBig := NewBigFloat(float64(exp), workPrec)
 		expTerm := new(BigFloat).SetPrec(workPrec).Mul(expBig, ln2)
 		ws.result.Add(ws.result, expTerm)
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// BigFloatToSignificantDigits formats x with exactly sig significant digits
+// Like %g, it uses fixed notation when the decimal exponent e of the rounded
+// value satisfies -4 <= e < sig and scientific notation otherwise, but
+// trailing zeros are kept, so 1.5 with sig = 4 is "1.500". Infinities are
+// "+Inf" and "-Inf". sig values below 1 are treated as 1.
+func BigFloatToSignificantDigits(x *BigFloat, sig int) string {
+	if x.IsInf() {
+		if x.Sign() > 0 {
+			return "+Inf"
+		}
+		return "-Inf"
+	}
+	if sig < 1 {
+		sig = 1
+	}
+
+	// Rounding to sig digits may carry into a new decade (9.99 → 1.00e+01),
+	// so the exponent is read from the rounded scientific form
+	sci := x.Text('e', sig-1)
+	exp, _ := strconv.Atoi(sci[strings.LastIndexByte(sci, 'e')+1:])
+	if exp < -4 || exp >= sig {
+		return sci
+	}
+	return x.Text('f', sig-1-exp)
+}
+
+// BigFloatToExactDecimal returns the exact decimal expansion of x with no
+// rounding. Every finite binary value m/2^k terminates after k decimal places,
+// since m/2^k = m·5^k/10^k, so 0.1 at 256 bits prints all of its digits rather
+// than "0.1". Integers have no decimal point, zeros are "0" or "-0", and
+// infinities are "+Inf" and "-Inf".
+func BigFloatToExactDecimal(x *BigFloat) string {
+	if x.IsInf() {
+		return BigFloatToSignificantDigits(x, 1)
+	}
+	if x.Sign() == 0 {
+		if x.Signbit() {
+			return "-0"
+		}
+		return "0"
+	}
+
+	// x = num/2^k exactly, with num odd when k > 0
+	r, _ := x.Rat(nil)
+	num := new(big.Int).Abs(r.Num())
+	k := r.Denom().BitLen() - 1
+
+	var sb strings.Builder
+	if x.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+	if k == 0 {
+		sb.WriteString(num.String())
+		return sb.String()
+	}
+
+	digits := num.Mul(num, new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(k)), nil)).String()
+	if len(digits) <= k {
+		digits = strings.Repeat("0", k-len(digits)+1) + digits
+	}
+	sb.WriteString(digits[:len(digits)-k])
+	sb.WriteByte('.')
+	sb.WriteString(digits[len(digits)-k:])
+	return sb.String()
+}
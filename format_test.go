@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBigFloatToSignificantDigits(t *testing.T) {
+	prec := uint(256)
+	parse := func(s string) *BigFloat {
+		x, _ := NewBigFloatFromString(s, prec)
+		return x
+	}
+
+	tests := []struct {
+		name string
+		x    *BigFloat
+		sig  int
+		want string
+	}{
+		{"pi_10", BigPI(prec), 10, "3.141592654"},
+		{"pi_1", BigPI(prec), 1, "3"},
+		{"neg_pi_5", new(BigFloat).Neg(BigPI(prec)), 5, "-3.1416"},
+		{"tiny", parse("1e-100"), 5, "1.0000e-100"},
+		{"tiny_neg", parse("-2.5e-100"), 3, "-2.50e-100"},
+		{"padded", parse("1.5"), 4, "1.500"},
+		{"integer_padded", parse("42"), 5, "42.000"},
+		{"large", parse("123456789"), 4, "1.235e+08"},
+		{"exponent_equals_sig", parse("1234"), 4, "1234"},
+		{"carry_to_next_decade", parse("9.9996"), 4, "10.00"},
+		{"carry_to_scientific", parse("99996"), 4, "1.000e+05"},
+		{"small_fixed", parse("0.00012345"), 3, "0.000123"},
+		{"small_scientific", parse("0.000012345"), 3, "1.23e-05"},
+		{"zero", parse("0"), 3, "0.00"},
+		{"sig_below_one", parse("0.75"), 0, "0.8"},
+		{"pos_inf", new(BigFloat).SetInf(false), 5, "+Inf"},
+		{"neg_inf", new(BigFloat).SetInf(true), 5, "-Inf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BigFloatToSignificantDigits(tt.x, tt.sig); got != tt.want {
+				t.Errorf("BigFloatToSignificantDigits(%s, %d) = %q, want %q", tt.x.Text('g', 20), tt.sig, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBigFloatToExactDecimal(t *testing.T) {
+	t.Run("values", func(t *testing.T) {
+		tests := []struct {
+			x    *BigFloat
+			want string
+		}{
+			{NewBigFloat(0.5, 64), "0.5"},
+			{NewBigFloat(-0.375, 64), "-0.375"},
+			{NewBigFloat(3, 64), "3"},
+			{NewBigFloat(-1024, 64), "-1024"},
+			{NewBigFloat(1.0/1024, 64), "0.0009765625"},
+			// float64 0.1 is the nearest double, not one tenth
+			{NewBigFloat(0.1, 53), "0.1000000000000000055511151231257827021181583404541015625"},
+			{NewBigFloat(0, 64), "0"},
+			{new(BigFloat).Neg(NewBigFloat(0, 64)), "-0"},
+			{new(BigFloat).SetInf(false), "+Inf"},
+			{new(BigFloat).SetInf(true), "-Inf"},
+		}
+		for _, tt := range tests {
+			if got := BigFloatToExactDecimal(tt.x); got != tt.want {
+				t.Errorf("BigFloatToExactDecimal(%s) = %q, want %q", tt.x.Text('g', 20), got, tt.want)
+			}
+		}
+	})
+
+	t.Run("round_trip", func(t *testing.T) {
+		prec := uint(256)
+		tenth, _ := NewBigFloatFromString("0.1", prec)
+		huge, _ := NewBigFloatFromString("1.2345e300", prec)
+		values := []*BigFloat{
+			tenth,
+			new(BigFloat).Neg(BigPI(prec)),
+			BigSqrt(NewBigFloat(2, prec), prec),
+			huge,
+			new(BigFloat).SetPrec(prec).SetMantExp(NewBigFloat(1, prec), -1074),
+		}
+		for _, x := range values {
+			s := BigFloatToExactDecimal(x)
+			r, ok := new(big.Rat).SetString(s)
+			if !ok {
+				t.Fatalf("big.Rat could not parse %q", s)
+			}
+			want, _ := x.Rat(nil)
+			if r.Cmp(want) != 0 {
+				t.Errorf("BigFloatToExactDecimal(%s) = %q does not parse back to the same value", x.Text('g', 20), s)
+			}
+		}
+
+		// 0.1 at 256 bits is m/2^259, so it prints 259 decimal places
+		if s := BigFloatToExactDecimal(tenth); len(s) != len("0.")+259 || s[:20] != "0.100000000000000000" {
+			t.Errorf("BigFloatToExactDecimal(0.1 at 256 bits) = %q (%d chars), want 259 decimal places", s, len(s))
+		}
+	})
+}
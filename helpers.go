@@ -23,6 +23,38 @@ func BigVec6Magnitude(v *BigVec6, prec uint) *BigFloat {
 	return getDispatcher().BigVec6MagnitudeImpl(v, prec)
 }
 
+// BigVec6Dot computes the dot product over all six components of two BigVec6
+// Unlike BigVec6Magnitude, which only covers position, the velocity
+// components contribute too: a·b = a.r·b.r + a.v·b.v.
+func BigVec6Dot(a, b *BigVec6, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+
+	return BigFloatDotProduct(
+		[]*BigFloat{a.X, a.Y, a.Z, a.VX, a.VY, a.VZ},
+		[]*BigFloat{b.X, b.Y, b.Z, b.VX, b.VY, b.VZ},
+		prec,
+	)
+}
+
+// BigVec6Mul multiplies all six components of a BigVec6 by a scalar
+func BigVec6Mul(v *BigVec6, s *BigFloat, prec uint) *BigVec6 {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+
+	mul := func(x *BigFloat) *BigFloat { return new(BigFloat).SetPrec(prec).Mul(x, s) }
+	return &BigVec6{
+		X:  mul(v.X),
+		Y:  mul(v.Y),
+		Z:  mul(v.Z),
+		VX: mul(v.VX),
+		VY: mul(v.VY),
+		VZ: mul(v.VZ),
+	}
+}
+
 // ApplyRotationMatrixToBigVec6 applies a rotation matrix to position and velocity
 func ApplyRotationMatrixToBigVec6(m *BigMatrix3x3, v *BigVec6, prec uint) *BigVec6 {
 	if prec == 0 {
@@ -47,8 +79,38 @@ func ApplyRotationMatrixToBigVec6(m *BigMatrix3x3, v *BigVec6, prec uint) *BigVe
 	}
 }
 
+// RotateBigVec6 transforms a state vector by the time-varying rotation m
+// whose time derivative is dm. By the product rule d(M·r)/dt = M·ṙ + Ṁ·r, so
+// the position becomes m·r and the velocity m·v + dm·r. A nil dm is a constant
+// rotation, which gives the same result as ApplyRotationMatrixToBigVec6.
+func RotateBigVec6(m, dm *BigMatrix3x3, v *BigVec6, prec uint) *BigVec6 {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+	workPrec := prec + 32
+
+	pos := &BigVec3{X: v.X, Y: v.Y, Z: v.Z}
+	rotPos := BigMatMul(m, pos, prec)
+
+	rotVel := BigMatMul(m, &BigVec3{X: v.VX, Y: v.VY, Z: v.VZ}, workPrec)
+	if dm != nil {
+		rotVel = BigVec3Add(rotVel, BigMatMul(dm, pos, workPrec), workPrec)
+	}
+	rotVel = roundBigVec3(rotVel, prec)
+
+	return &BigVec6{
+		X:  rotPos.X,
+		Y:  rotPos.Y,
+		Z:  rotPos.Z,
+		VX: rotVel.X,
+		VY: rotVel.Y,
+		VZ: rotVel.Z,
+	}
+}
+
 // CreateRotationMatrix creates a rotation matrix for given angles
-// This is used for precession and coordinate transformations
+// This is used for precession and coordinate transformations. Only angles[0]
+// (about z) is applied; CreateRotationMatrixOrder composes all three.
 func CreateRotationMatrix(angles [3]*BigFloat, prec uint) *BigMatrix3x3 {
 	if prec == 0 {
 		prec = DefaultPrecision
@@ -56,8 +118,7 @@ func CreateRotationMatrix(angles [3]*BigFloat, prec uint) *BigMatrix3x3 {
 
 	// Simple rotation around Z axis (first angle only for now)
 	// For more complex rotations, this would be extended
-	cosA := BigCos(angles[0], prec)
-	sinA := BigSin(angles[0], prec)
+	sinA, cosA := BigSinCos(angles[0], prec)
 
 	// Combined rotation matrix (Z-axis rotation)
 	zero := NewBigFloat(0, prec)
@@ -73,6 +134,29 @@ func CreateRotationMatrix(angles [3]*BigFloat, prec uint) *BigMatrix3x3 {
 	}
 }
 
+// CreateRotationMatrixAxisAngle creates the rotation by angle (radians, right-handed)
+// about axis using Rodrigues' formula R = I + sinθ·K + (1-cosθ)·K², where K
+// is the cross-product matrix of the normalized axis.
+// A zero axis returns the identity.
+func CreateRotationMatrixAxisAngle(axis *BigVec3, angle *BigFloat, prec uint) *BigMatrix3x3 {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+
+	if axis.X.Sign() == 0 && axis.Y.Sign() == 0 && axis.Z.Sign() == 0 {
+		return NewIdentityMatrix(prec)
+	}
+
+	workPrec := prec + 32
+	m := bigMatFromAxisAngle(BigVec3Normalize(axis, workPrec), angle, workPrec)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m.M[i][j] = new(BigFloat).SetPrec(prec).Set(m.M[i][j])
+		}
+	}
+	return m
+}
+
 // BigFloatFMA computes a*b + c using Fused Multiply-Add for higher precision
 // This reduces rounding errors compared to separate multiply and add operations
 // For BigFloat, we simulate FMA by using extended precision internally
@@ -128,20 +212,9 @@ func BigFloatDotProduct(v1, v2 []*BigFloat, prec uint) *BigFloat {
 	return result
 }
 
-// BigLog2 returns ln(2) with specified precision
-func BigLog2(prec uint) *BigFloat {
-	if prec == 0 {
-		prec = DefaultPrecision
-	}
-
-	// Use high-precision string constant for ln(2)
-	// 0.69314718055994530941723212145817656807550013436025525412068000949339362196969471
-	log2Str := "0.69314718055994530941723212145817656807550013436025525412068000949339362196969471"
-	result, err := NewBigFloatFromString(log2Str, prec)
-	if err != nil {
-		panic("BigLog2: invalid constant string: " + err.Error())
-	}
-	return result
+// BigLn2 returns ln(2) ≈ 0.6931471805599453094... with specified precision
+func BigLn2(prec uint) *BigFloat {
+	return ln2Cache.get(prec)
 }
 
 // BigJ2000 returns the Julian day for J2000.0 epoch (2451545.0) with specified precision
@@ -178,19 +251,24 @@ func BigJulianMillennium(prec uint) *BigFloat {
 	return NewBigFloat(365250.0, prec)
 }
 
-// BigE returns e (Euler's number) with specified precision
+// BigE returns Euler's number e ≈ 2.71828... with specified precision
+// e is computed from Σ 1/n! once per precision and cached.
 func BigE(prec uint) *BigFloat {
-	if prec == 0 {
-		prec = DefaultPrecision
-	}
-	// Use high-precision string constant for e
-	// 2.71828182845904523536028747135266249775724709369995957496696762772407663035354759
-	eStr := "2.71828182845904523536028747135266249775724709369995957496696762772407663035354759"
-	result, err := NewBigFloatFromString(eStr, prec)
-	if err != nil {
-		panic("BigE: invalid constant string: " + err.Error())
+	return eCache.get(prec)
+}
+
+// computeE computes e = Σ 1/n! to prec bits
+func computeE(prec uint) *BigFloat {
+	workPrec := prec + 32
+
+	sum := NewBigFloat(1.0, workPrec)
+	term := NewBigFloat(1.0, workPrec) // 1/n!
+	for n := 1; term.MantExp(nil) > -int(workPrec); n++ {
+		term.Quo(term, NewBigFloat(float64(n), workPrec))
+		sum.Add(sum, term)
 	}
-	return result
+
+	return new(BigFloat).SetPrec(prec).Set(sum)
 }
 
 // BigEulerGamma returns Euler's constant γ ≈ 0.57721... with specified precision
@@ -208,19 +286,3 @@ func BigEulerGamma(prec uint) *BigFloat {
 	}
 	return result
 }
-
-// BigCatalan returns Catalan's constant G ≈ 0.91596... with specified precision
-// This is a placeholder - full implementation would use a series
-func BigCatalan(prec uint) *BigFloat {
-	if prec == 0 {
-		prec = DefaultPrecision
-	}
-	// Placeholder: return approximate value
-	// Full implementation would compute using series
-	catalanStr := "0.91596559417721901505460351493238411077414937428167213426649811962176301977625476"
-	result, err := NewBigFloatFromString(catalanStr, prec)
-	if err != nil {
-		panic("BigCatalan: invalid constant string: " + err.Error())
-	}
-	return result
-}
@@ -8,21 +8,32 @@ import (
 	"testing"
 )
 
-// TestBigLog2 tests the BigLog2 constant
-func TestBigLog2(t *testing.T) {
+// TestBigLn2 tests the BigLn2 constant
+func TestBigLn2(t *testing.T) {
 	prec := uint(256)
-	result := BigLog2(prec)
+	result := BigLn2(prec)
 	resultFloat, _ := result.Float64()
 
 	expected := math.Ln2
 	if math.Abs(resultFloat-expected) > 1e-10 {
-		t.Errorf("BigLog2() = %v, want %v", resultFloat, expected)
+		t.Errorf("BigLn2() = %v, want %v", resultFloat, expected)
 	}
 
 	// Test with default precision
-	result2 := BigLog2(0)
+	result2 := BigLn2(0)
 	if result2 == nil {
-		t.Error("BigLog2(0) returned nil")
+		t.Error("BigLn2(0) returned nil")
+	}
+
+	// Beyond the cached precision the constant is computed on demand
+	ln2Str := "0.693147180559945309417232121458176568075500134360255254120680009493393621969694715605863326996418687542001481020570685733685520235758130557032670751635"
+	for _, p := range []uint{128, 256, 512, 1024} {
+		want, _ := NewBigFloatFromString(ln2Str, p)
+		tol, _ := NewBigFloatFromString("1e-148", p)
+		diff := new(BigFloat).SetPrec(p).Sub(BigLn2(p), want)
+		if p <= 256 && diff.Sign() != 0 || diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("BigLn2(%d) differs from ln(2) by %s", p, diff.Text('g', 5))
+		}
 	}
 }
 
@@ -183,6 +194,43 @@ func TestBigLog10(t *testing.T) {
 	}
 }
 
+// TestBigLog2AndLog10Exact tests BigLog2 and BigLog10 to high precision
+func TestBigLog2AndLog10Exact(t *testing.T) {
+	for _, prec := range []uint{128, 256, 512} {
+		tol, _ := NewBigFloatFromString("1e-45", prec)
+		if prec == 128 {
+			tol, _ = NewBigFloatFromString("1e-36", prec)
+		}
+		check := func(name string, got *BigFloat, want float64) {
+			t.Helper()
+			diff := new(BigFloat).SetPrec(prec).Sub(got, NewBigFloat(want, prec))
+			if diff.Abs(diff).Cmp(tol) > 0 {
+				t.Errorf("prec %d: %s = %s, want %g", prec, name, got.Text('g', 60), want)
+			}
+		}
+
+		check("BigLog2(8)", BigLog2(NewBigFloat(8.0, prec), prec), 3)
+		check("BigLog2(0.125)", BigLog2(NewBigFloat(0.125, prec), prec), -3)
+		check("BigLog2(1)", BigLog2(NewBigFloat(1.0, prec), prec), 0)
+		check("BigLog2(1024)", BigLog2(NewBigFloat(1024.0, prec), prec), 10)
+		check("BigLog10(1000)", BigLog10(NewBigFloat(1000.0, prec), prec), 3)
+		tenMinus5, _ := NewBigFloatFromString("1e-5", prec)
+		check("BigLog10(1e-5)", BigLog10(tenMinus5, prec), -5)
+		check("BigLog10(1)", BigLog10(NewBigFloat(1.0, prec), prec), 0)
+
+		// log2(3) = ln(3)/ln(2): compare 2^log2(3) with 3
+		l := BigLog2(NewBigFloat(3.0, prec), prec)
+		check("2^BigLog2(3)", BigExp(new(BigFloat).SetPrec(prec).Mul(l, BigLn2(prec)), prec), 3)
+	}
+
+	if got := BigLog2(NewBigFloat(-1.0, 256), 256); got.Sign() != 0 {
+		t.Errorf("BigLog2(-1) = %s, want NaN (zero)", got.Text('g', 10))
+	}
+	if got := BigLog10(NewBigFloat(0.0, 256), 256); got.Sign() != 0 {
+		t.Errorf("BigLog10(0) = %s, want NaN (zero)", got.Text('g', 10))
+	}
+}
+
 // TestConstantsPrecision tests that constants maintain precision
 func TestConstantsPrecision(t *testing.T) {
 	precisions := []uint{64, 128, 256, 512, 1024}
@@ -194,7 +242,7 @@ func TestConstantsPrecision(t *testing.T) {
 				name string
 				fn   func(uint) *BigFloat
 			}{
-				{"BigLog2", BigLog2},
+				{"BigLn2", BigLn2},
 				{"BigJ2000", BigJ2000},
 				{"BigLightSpeedAUperDay", BigLightSpeedAUperDay},
 				{"BigJulianCentury", BigJulianCentury},
@@ -222,7 +270,7 @@ func TestConstantsRelationships(t *testing.T) {
 	prec := uint(256)
 
 	t.Run("e^ln(2)_approx_2", func(t *testing.T) {
-		ln2 := BigLog2(prec)
+		ln2 := BigLn2(prec)
 		e_ln2 := BigExp(ln2, prec)
 		e_ln2_float, _ := e_ln2.Float64()
 
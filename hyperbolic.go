@@ -193,3 +193,70 @@ func bigAtanhGeneric(x *BigFloat, prec uint) *BigFloat {
 
 	return new(BigFloat).SetPrec(prec).Set(res)
 }
+
+// hyperbolicReciprocalPrec returns the working precision for coth and csch
+// tanh and sinh lose about -log2|x| bits to cancellation for small |x|, so
+// those bits are added on top of the usual guard bits.
+func hyperbolicReciprocalPrec(x *BigFloat, prec uint) uint {
+	workPrec := prec + 32
+	if exp := x.MantExp(nil); exp < 0 {
+		workPrec += uint(-exp)
+	}
+	return workPrec
+}
+
+// BigCoth computes coth(x) = 1 / tanh(x)
+// Returns ±Inf at x = 0, with the sign of the zero.
+func BigCoth(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	if x.Sign() == 0 {
+		return new(BigFloat).SetPrec(prec).SetInf(x.Signbit())
+	}
+	if x.IsInf() {
+		return NewBigFloat(float64(x.Sign()), prec)
+	}
+
+	workPrec := hyperbolicReciprocalPrec(x, prec)
+	res := new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(1.0, workPrec), BigTanh(x, workPrec))
+
+	return new(BigFloat).SetPrec(prec).Set(res)
+}
+
+// BigSech computes sech(x) = 1 / cosh(x)
+func BigSech(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	if x.IsInf() {
+		return NewBigFloat(0.0, prec)
+	}
+
+	workPrec := prec + 32
+	res := new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(1.0, workPrec), BigCosh(x, workPrec))
+
+	return new(BigFloat).SetPrec(prec).Set(res)
+}
+
+// BigCsch computes csch(x) = 1 / sinh(x)
+// Returns ±Inf at x = 0, with the sign of the zero.
+func BigCsch(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	if x.Sign() == 0 {
+		return new(BigFloat).SetPrec(prec).SetInf(x.Signbit())
+	}
+	if x.IsInf() {
+		return NewBigFloat(0.0, prec)
+	}
+
+	workPrec := hyperbolicReciprocalPrec(x, prec)
+	res := new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(1.0, workPrec), BigSinh(x, workPrec))
+
+	return new(BigFloat).SetPrec(prec).Set(res)
+}
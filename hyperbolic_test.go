@@ -287,6 +287,66 @@ func TestHyperbolicIdentities(t *testing.T) {
 	})
 }
 
+// TestBigReciprocalHyperbolic tests BigCoth, BigSech and BigCsch
+func TestBigReciprocalHyperbolic(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func(*BigFloat, uint) *BigFloat
+		x         float64
+		expected  float64
+		tolerance float64
+	}{
+		{"sech_zero", BigSech, 0.0, 1.0, 1e-10},
+		{"sech_one", BigSech, 1.0, 1 / math.Cosh(1.0), 1e-10},
+		{"sech_negative", BigSech, -2.0, 1 / math.Cosh(-2.0), 1e-10},
+		{"coth_one", BigCoth, 1.0, 1 / math.Tanh(1.0), 1e-10},
+		{"coth_negative", BigCoth, -0.5, 1 / math.Tanh(-0.5), 1e-10},
+		{"coth_large", BigCoth, 50.0, 1.0, 1e-15},
+		{"coth_large_negative", BigCoth, -50.0, -1.0, 1e-15},
+		{"csch_one", BigCsch, 1.0, 1 / math.Sinh(1.0), 1e-10},
+		{"csch_negative", BigCsch, -3.0, 1 / math.Sinh(-3.0), 1e-10},
+		{"csch_small", BigCsch, 1e-8, 1 / math.Sinh(1e-8), 1e-2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.fn(NewBigFloat(tt.x, 256), 256)
+			resultFloat, _ := result.Float64()
+			if math.Abs(resultFloat-tt.expected) > tt.tolerance {
+				t.Errorf("%s(%v) = %v, want %v", tt.name, tt.x, resultFloat, tt.expected)
+			}
+		})
+	}
+
+	t.Run("poles_at_zero", func(t *testing.T) {
+		zero := NewBigFloat(0.0, 256)
+		negZero := new(BigFloat).Neg(zero)
+		if r := BigCoth(zero, 256); !r.IsInf() || r.Sign() < 0 {
+			t.Errorf("BigCoth(0) = %v, want +Inf", r)
+		}
+		if r := BigCsch(negZero, 256); !r.IsInf() || r.Sign() > 0 {
+			t.Errorf("BigCsch(-0) = %v, want -Inf", r)
+		}
+	})
+
+	// coth² - csch² = 1, including small arguments where tanh and sinh cancel
+	t.Run("identity", func(t *testing.T) {
+		prec := uint(256)
+		tol, _ := NewBigFloatFromString("1e-40", prec)
+		for _, s := range []string{"1e-20", "0.01", "0.7", "-1.5", "3", "-10"} {
+			x, _ := NewBigFloatFromString(s, prec)
+			coth := BigCoth(x, prec)
+			csch := BigCsch(x, prec)
+			diff := new(BigFloat).SetPrec(prec).Mul(coth, coth)
+			diff.Sub(diff, new(BigFloat).SetPrec(prec).Mul(csch, csch))
+			diff.Sub(diff, NewBigFloat(1.0, prec))
+			if diff.Abs(diff).Cmp(tol) > 0 {
+				t.Errorf("coth²(%s) - csch²(%s) - 1 = %s", s, s, diff.Text('g', 5))
+			}
+		}
+	})
+}
+
 // TestHyperbolicPrecisionLevels tests hyperbolic functions at different precision levels
 func TestHyperbolicPrecisionLevels(t *testing.T) {
 	x := 0.7
@@ -328,6 +388,12 @@ func TestHyperbolicPrecisionLevels(t *testing.T) {
 			if atanhX == nil {
 				t.Error("BigAtanh returned nil")
 			}
+
+			for name, fn := range map[string]func(*BigFloat, uint) *BigFloat{"BigCoth": BigCoth, "BigSech": BigSech, "BigCsch": BigCsch} {
+				if r := fn(xBig, prec); r == nil || r.Prec() != prec {
+					t.Errorf("%s returned %v at precision %d", name, r, prec)
+				}
+			}
 		})
 	}
 }
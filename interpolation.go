@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"errors"
+)
+
+// slerpUnit interpolates between unit vectors ua and ub separated by angle omega
+// p(t) = sin((1-t)ω)/sin(ω) * ua + sin(tω)/sin(ω) * ub
+// sinOmega must be non-zero
+func slerpUnit(ua, ub *BigVec3, omega, sinOmega, t *BigFloat, prec uint) *BigVec3 {
+	one := NewBigFloat(1.0, prec)
+	oneMinusT := new(BigFloat).SetPrec(prec).Sub(one, t)
+
+	wa := BigSin(new(BigFloat).SetPrec(prec).Mul(oneMinusT, omega), prec)
+	wa.Quo(wa, sinOmega)
+	wb := BigSin(new(BigFloat).SetPrec(prec).Mul(t, omega), prec)
+	wb.Quo(wb, sinOmega)
+
+	return BigVec3Add(BigVec3Mul(ua, wa, prec), BigVec3Mul(ub, wb, prec), prec)
+}
+
+// BigVec3Lerp linearly interpolates between a and b: a + (b-a)·t
+// t = 0 gives a and t = 1 gives b; t outside [0, 1] extrapolates.
+func BigVec3Lerp(a, b *BigVec3, t *BigFloat, prec uint) *BigVec3 {
+	if prec == 0 {
+		prec = a.X.Prec()
+	}
+
+	workPrec := prec + 32
+	step := BigVec3Mul(BigVec3Sub(b, a, workPrec), t, workPrec)
+	return roundBigVec3(BigVec3Add(a, step, workPrec), prec)
+}
+
+// BigVec3Slerp spherically interpolates between the unit directions a and b
+// p(t) = sin((1-t)ω)/sin(ω)·a + sin(tω)/sin(ω)·b, where ω = BigVec3Angle(a, b).
+// When sin(ω) is below 2^(-prec/2) the weights are numerically unreliable,
+// so nearly parallel (and nearly antipodal) inputs fall back to BigVec3Lerp.
+func BigVec3Slerp(a, b *BigVec3, t *BigFloat, prec uint) *BigVec3 {
+	if prec == 0 {
+		prec = a.X.Prec()
+	}
+
+	workPrec := prec + 32
+	omega := BigVec3Angle(a, b, workPrec)
+	sinOmega := BigSin(omega, workPrec)
+
+	threshold := new(BigFloat).SetMantExp(NewBigFloat(1.0, workPrec), -int(prec/2))
+	if sinOmega.Cmp(threshold) < 0 {
+		return BigVec3Lerp(a, b, t, prec)
+	}
+
+	return roundBigVec3(slerpUnit(a, b, omega, sinOmega, t, workPrec), prec)
+}
+
+// BigVec3GreatCirclePath returns n unit vectors evenly spaced by angle along the
+// shorter great-circle arc from the direction of a to the direction of b.
+// Both endpoints are included. Returns an error if n < 2, if either input is
+// the zero vector, or if the inputs are antipodal (the arc is undefined).
+func BigVec3GreatCirclePath(a, b *BigVec3, n int, prec uint) ([]*BigVec3, error) {
+	if n < 2 {
+		return nil, errors.New("great-circle path needs at least 2 points")
+	}
+	if prec == 0 {
+		prec = a.X.Prec()
+	}
+
+	workPrec := prec + 32
+
+	ua := BigVec3Normalize(a, workPrec)
+	ub := BigVec3Normalize(b, workPrec)
+	if BigVec3Dot(ua, ua, workPrec).Sign() == 0 || BigVec3Dot(ub, ub, workPrec).Sign() == 0 {
+		return nil, errors.New("great-circle path is undefined for a zero vector")
+	}
+
+	// sin(ω) = |ua × ub|, cos(ω) = ua · ub
+	sinOmega := BigVec3Magnitude(BigVec3Cross(ua, ub, workPrec), workPrec)
+	cosOmega := BigVec3Dot(ua, ub, workPrec)
+
+	// Antipodal directions have infinitely many connecting great circles
+	threshold := new(BigFloat).SetMantExp(NewBigFloat(1.0, workPrec), -int(prec/2))
+	if cosOmega.Sign() < 0 && sinOmega.Cmp(threshold) < 0 {
+		return nil, errors.New("great-circle path is undefined for antipodal vectors")
+	}
+
+	path := make([]*BigVec3, n)
+	path[0] = roundBigVec3(ua, prec)
+	path[n-1] = roundBigVec3(ub, prec)
+
+	if sinOmega.Sign() == 0 {
+		// Identical directions: every waypoint is the same point
+		for i := 1; i < n-1; i++ {
+			path[i] = roundBigVec3(ua, prec)
+		}
+		return path, nil
+	}
+
+	omega := BigAtan2(sinOmega, cosOmega, workPrec)
+	steps := NewBigFloat(float64(n-1), workPrec)
+	for i := 1; i < n-1; i++ {
+		t := new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(float64(i), workPrec), steps)
+		path[i] = roundBigVec3(slerpUnit(ua, ub, omega, sinOmega, t, workPrec), prec)
+	}
+
+	return path, nil
+}
+
+// roundBigVec3 returns a copy of v with every component rounded to prec
+func roundBigVec3(v *BigVec3, prec uint) *BigVec3 {
+	return &BigVec3{
+		X: new(BigFloat).SetPrec(prec).Set(v.X),
+		Y: new(BigFloat).SetPrec(prec).Set(v.Y),
+		Z: new(BigFloat).SetPrec(prec).Set(v.Z),
+	}
+}
+
+// BigMatSlerp interpolates between the rotation matrices a and b along the
+// shortest rotation path. The relative rotation R = aᵀb is converted to
+// axis-angle form, its angle is scaled by t, and a·R(t) is returned, so t = 0
+// gives a and t = 1 gives b. Returns an error if either input is not a
+// proper rotation matrix.
+func BigMatSlerp(a, b *BigMatrix3x3, t *BigFloat, prec uint) (*BigMatrix3x3, error) {
+	if prec == 0 {
+		prec = a.M[0][0].Prec()
+	}
+
+	workPrec := prec + 32
+	tol := new(BigFloat).SetMantExp(NewBigFloat(1.0, workPrec), -int(prec/2))
+	for _, m := range []*BigMatrix3x3{a, b} {
+		if !BigMatIsOrthogonal(m, tol, workPrec) || BigMatDet(m, workPrec).Sign() < 0 {
+			return nil, errors.New("matrix slerp requires rotation matrices")
+		}
+	}
+
+	rel := BigMatMulMat(BigMatTranspose(a, workPrec), b, workPrec)
+	axis, angle := bigMatToAxisAngle(rel, workPrec)
+	angle.Mul(angle, t)
+
+	result := BigMatMulMat(a, bigMatFromAxisAngle(axis, angle, workPrec), workPrec)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			result.M[i][j] = new(BigFloat).SetPrec(prec).Set(result.M[i][j])
+		}
+	}
+	return result, nil
+}
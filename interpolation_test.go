@@ -0,0 +1,253 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBigVec3Lerp(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	a := NewBigVec3(1.5, -2, 3, prec)
+	b := NewBigVec3(-4, 6, 0.25, prec)
+
+	tests := []struct {
+		name     string
+		t        float64
+		expected *BigVec3
+	}{
+		{"start", 0, a},
+		{"end", 1, b},
+		{"midpoint", 0.5, NewBigVec3(-1.25, 2, 1.625, prec)},
+		{"extrapolate", 2, NewBigVec3(-9.5, 14, -2.5, prec)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BigVec3Lerp(a, b, NewBigFloat(tt.t, prec), prec)
+			if !BigVec3Equal(got, tt.expected, tol) {
+				t.Errorf("BigVec3Lerp(t=%g) = %v, want %v", tt.t, got.ToFloat64(), tt.expected.ToFloat64())
+			}
+		})
+	}
+}
+
+func TestBigVec3Slerp(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+	one := NewBigFloat(1.0, prec)
+
+	a := NewBigVec3(1, 0, 0, prec)
+	b := NewBigVec3(0, 0, 1, prec)
+
+	t.Run("orthogonal_midpoint", func(t *testing.T) {
+		mid := BigVec3Slerp(a, b, NewBigFloat(0.5, prec), prec)
+		diff := new(BigFloat).SetPrec(prec).Sub(BigVec3Magnitude(mid, prec), one)
+		if diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("|slerp(0.5)| = %s, want 1", BigVec3Magnitude(mid, prec).Text('g', 40))
+		}
+		s := BigSqrt(NewBigFloat(0.5, prec), prec)
+		if !BigVec3Equal(mid, &BigVec3{X: s, Y: NewBigFloat(0, prec), Z: s}, tol) {
+			t.Errorf("slerp(0.5) = %v, want (√½, 0, √½)", mid.ToFloat64())
+		}
+	})
+
+	t.Run("endpoints", func(t *testing.T) {
+		if got := BigVec3Slerp(a, b, NewBigFloat(0, prec), prec); !BigVec3Equal(got, a, tol) {
+			t.Errorf("slerp(0) = %v, want a", got.ToFloat64())
+		}
+		if got := BigVec3Slerp(a, b, NewBigFloat(1, prec), prec); !BigVec3Equal(got, b, tol) {
+			t.Errorf("slerp(1) = %v, want b", got.ToFloat64())
+		}
+	})
+
+	t.Run("constant_angular_speed", func(t *testing.T) {
+		// A third of the way along a 90° arc is 30° from a
+		got := BigVec3Slerp(a, b, new(BigFloat).SetPrec(prec).Quo(one, NewBigFloat(3, prec)), prec)
+		angle := BigVec3Angle(a, got, prec)
+		want := BigPI(prec)
+		want.Quo(want, NewBigFloat(6, prec))
+		diff := new(BigFloat).SetPrec(prec).Sub(angle, want)
+		if diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("angle after t=1/3 = %s, want π/6", angle.Text('g', 40))
+		}
+	})
+
+	t.Run("parallel_falls_back_to_lerp", func(t *testing.T) {
+		got := BigVec3Slerp(a, a, NewBigFloat(0.25, prec), prec)
+		if !BigVec3Equal(got, a, tol) {
+			t.Errorf("slerp of identical vectors = %v, want a", got.ToFloat64())
+		}
+	})
+}
+
+func TestBigVec3GreatCirclePath(t *testing.T) {
+	prec := uint(256)
+
+	t.Run("quarter_circle_midpoint", func(t *testing.T) {
+		a := NewBigVec3(1.0, 0.0, 0.0, prec)
+		b := NewBigVec3(0.0, 1.0, 0.0, prec)
+		path, err := BigVec3GreatCirclePath(a, b, 3, prec)
+		if err != nil {
+			t.Fatalf("BigVec3GreatCirclePath error: %v", err)
+		}
+		if len(path) != 3 {
+			t.Fatalf("len(path) = %d, want 3", len(path))
+		}
+
+		mid := path[1].ToFloat64()
+		half := math.Sqrt2 / 2
+		expected := [3]float64{half, half, 0.0}
+		for i := 0; i < 3; i++ {
+			if math.Abs(mid[i]-expected[i]) > 1e-15 {
+				t.Errorf("midpoint[%d] = %g, want %g", i, mid[i], expected[i])
+			}
+		}
+	})
+
+	t.Run("unit_length_and_endpoints", func(t *testing.T) {
+		a := NewBigVec3(3.0, -2.0, 1.0, prec)
+		b := NewBigVec3(-1.0, 4.0, 5.0, prec)
+		path, err := BigVec3GreatCirclePath(a, b, 7, prec)
+		if err != nil {
+			t.Fatalf("BigVec3GreatCirclePath error: %v", err)
+		}
+
+		tolerance := new(BigFloat).SetMantExp(NewBigFloat(1.0, prec), -200)
+		one := NewBigFloat(1.0, prec)
+		for i, p := range path {
+			diff := new(BigFloat).SetPrec(prec).Sub(BigVec3Magnitude(p, prec), one)
+			if diff.Abs(diff).Cmp(tolerance) > 0 {
+				t.Errorf("path[%d] magnitude differs from 1 by %s", i, diff.Text('g', 5))
+			}
+		}
+
+		ua := BigVec3Normalize(a, prec)
+		ub := BigVec3Normalize(b, prec)
+		for i, pair := range [][2]*BigVec3{{path[0], ua}, {path[len(path)-1], ub}} {
+			d := BigVec3Magnitude(BigVec3Sub(pair[0], pair[1], prec), prec)
+			if d.Cmp(tolerance) > 0 {
+				t.Errorf("endpoint %d differs from normalized input by %s", i, d.Text('g', 5))
+			}
+		}
+
+		// Consecutive waypoints are evenly spaced by angle
+		step := BigVec3Angle(path[0], path[1], prec)
+		for i := 1; i < len(path)-1; i++ {
+			angle := BigVec3Angle(path[i], path[i+1], prec)
+			d := new(BigFloat).SetPrec(prec).Sub(angle, step)
+			if df, _ := d.Float64(); math.Abs(df) > 1e-30 {
+				t.Errorf("step %d angle differs by %g", i, df)
+			}
+		}
+	})
+
+	t.Run("identical_directions", func(t *testing.T) {
+		a := NewBigVec3(0.0, 0.0, 2.0, prec)
+		b := NewBigVec3(0.0, 0.0, 5.0, prec)
+		path, err := BigVec3GreatCirclePath(a, b, 4, prec)
+		if err != nil {
+			t.Fatalf("BigVec3GreatCirclePath error: %v", err)
+		}
+		for i, p := range path {
+			if got := p.ToFloat64(); got != [3]float64{0, 0, 1} {
+				t.Errorf("path[%d] = %v, want [0 0 1]", i, got)
+			}
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		a := NewBigVec3(1.0, 2.0, 3.0, prec)
+		if _, err := BigVec3GreatCirclePath(a, BigVec3Mul(a, NewBigFloat(-2.0, prec), prec), 5, prec); err == nil {
+			t.Error("expected error for antipodal inputs")
+		}
+		if _, err := BigVec3GreatCirclePath(a, NewBigVec3(0, 1, 0, prec), 1, prec); err == nil {
+			t.Error("expected error for n < 2")
+		}
+		if _, err := BigVec3GreatCirclePath(a, NewBigVec3(0, 0, 0, prec), 3, prec); err == nil {
+			t.Error("expected error for zero vector")
+		}
+	})
+}
+
+func TestBigMatSlerp(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-50", prec)
+
+	zRotation := func(deg float64) *BigMatrix3x3 {
+		angle := new(BigFloat).SetPrec(prec).Mul(BigPI(prec), NewBigFloat(deg/180, prec))
+		return CreateRotationMatrix([3]*BigFloat{angle, NewBigFloat(0, prec), NewBigFloat(0, prec)}, prec)
+	}
+
+	assertMatClose := func(t *testing.T, got, want *BigMatrix3x3) {
+		t.Helper()
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				diff := new(BigFloat).SetPrec(prec).Sub(got.M[i][j], want.M[i][j])
+				if diff.Abs(diff).Cmp(tolerance) > 0 {
+					t.Errorf("M[%d][%d] = %s, want %s", i, j, got.M[i][j].Text('g', 30), want.M[i][j].Text('g', 30))
+				}
+			}
+		}
+	}
+
+	a := zRotation(30)
+	// 60° about the x axis applied after a
+	b := BigMatMulMat(a, bigMatFromAxisAngle(NewBigVec3(1, 0, 0, prec), new(BigFloat).SetPrec(prec).Quo(BigPI(prec), NewBigFloat(3, prec)), prec), prec)
+
+	t.Run("endpoints", func(t *testing.T) {
+		start, err := BigMatSlerp(a, b, NewBigFloat(0, prec), prec)
+		if err != nil {
+			t.Fatalf("BigMatSlerp error: %v", err)
+		}
+		assertMatClose(t, start, a)
+
+		end, err := BigMatSlerp(a, b, NewBigFloat(1, prec), prec)
+		if err != nil {
+			t.Fatalf("BigMatSlerp error: %v", err)
+		}
+		assertMatClose(t, end, b)
+	})
+
+	t.Run("identity_to_z90_half", func(t *testing.T) {
+		mid, err := BigMatSlerp(NewIdentityMatrix(prec), zRotation(90), NewBigFloat(0.5, prec), prec)
+		if err != nil {
+			t.Fatalf("BigMatSlerp error: %v", err)
+		}
+		assertMatClose(t, mid, zRotation(45))
+	})
+
+	t.Run("half_turn", func(t *testing.T) {
+		// The relative rotation is 180°, where the axis comes from the symmetric part
+		mid, err := BigMatSlerp(NewIdentityMatrix(prec), zRotation(180), NewBigFloat(0.5, prec), prec)
+		if err != nil {
+			t.Fatalf("BigMatSlerp error: %v", err)
+		}
+		want := zRotation(90)
+		if mid.M[0][1].Sign() > 0 {
+			// Rotating the other way round the z axis is equally short
+			want = zRotation(-90)
+		}
+		assertMatClose(t, mid, want)
+	})
+
+	t.Run("non_orthogonal", func(t *testing.T) {
+		shear := NewIdentityMatrix(prec)
+		shear.M[0][1] = NewBigFloat(0.5, prec)
+		if _, err := BigMatSlerp(NewIdentityMatrix(prec), shear, NewBigFloat(0.5, prec), prec); err == nil {
+			t.Error("BigMatSlerp with a shear matrix should return an error")
+		}
+	})
+
+	t.Run("reflection", func(t *testing.T) {
+		reflection := NewIdentityMatrix(prec)
+		reflection.M[2][2] = NewBigFloat(-1, prec)
+		if _, err := BigMatSlerp(reflection, NewIdentityMatrix(prec), NewBigFloat(0.5, prec), prec); err == nil {
+			t.Error("BigMatSlerp with a reflection should return an error")
+		}
+	})
+}
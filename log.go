@@ -23,6 +23,16 @@ func BigLog(x *BigFloat, prec uint) *BigFloat {
 	return getDispatcher().BigLogImpl(x, prec)
 }
 
+// BigLogRounded computes ln(x) and rounds the result according to the mode
+func BigLogRounded(x *BigFloat, prec uint, mode RoundingMode) (result *BigFloat, ternary int) {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	workPrec := prec + 32
+	res := BigLog(x, workPrec)
+	return Round(res, prec, mode)
+}
+
 // bigLogGeneric is the generic implementation (called by dispatcher)
 //
 //nolint:unused // Used in dispatch system and called from assembly
@@ -109,7 +119,7 @@ func bigLogGeneric(x *BigFloat, prec uint) *BigFloat {
 	res.Mul(res, scale)
 
 	// Add k*ln(2)
-	ln2 := BigLog2(workPrec)
+	ln2 := BigLn2(workPrec)
 	kLn2 := new(BigFloat).SetPrec(workPrec).Mul(NewBigFloat(float64(exp), workPrec), ln2)
 
 	res.Add(res, kLn2)
@@ -117,9 +127,47 @@ func bigLogGeneric(x *BigFloat, prec uint) *BigFloat {
 	return new(BigFloat).SetPrec(prec).Set(res)
 }
 
+// BigLog2 computes log2(x) = ln(x) / ln(2)
+// Exact powers of two return the exponent exactly. Returns NaN (zero) for x <= 0.
+func BigLog2(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	if x.Sign() <= 0 {
+		return NewBigFloat(math.NaN(), prec)
+	}
+	if x.IsInf() {
+		return new(BigFloat).SetPrec(prec).SetInf(false)
+	}
+
+	// x = 0.5 * 2^exp exactly
+	mant := new(BigFloat)
+	exp := x.MantExp(mant)
+	if mant.Cmp(NewBigFloat(0.5, mant.Prec())) == 0 {
+		return new(BigFloat).SetPrec(prec).SetInt64(int64(exp - 1))
+	}
+
+	workPrec := prec + 32
+	lnX := BigLog(x, workPrec)
+	res := new(BigFloat).SetPrec(workPrec).Quo(lnX, BigLn2(workPrec))
+	return new(BigFloat).SetPrec(prec).Set(res)
+}
+
 // BigLog10 computes log10(x) = ln(x) / ln(10)
+// Returns NaN (zero) for x <= 0.
 func BigLog10(x *BigFloat, prec uint) *BigFloat {
-	lnX := BigLog(x, prec)
-	ln10 := BigLog(NewBigFloat(10.0, prec), prec)
-	return new(BigFloat).SetPrec(prec).Quo(lnX, ln10)
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	if x.Sign() <= 0 {
+		return NewBigFloat(math.NaN(), prec)
+	}
+	if x.IsInf() {
+		return new(BigFloat).SetPrec(prec).SetInf(false)
+	}
+
+	workPrec := prec + 32
+	lnX := BigLog(x, workPrec)
+	res := new(BigFloat).SetPrec(workPrec).Quo(lnX, BigLn10(workPrec))
+	return new(BigFloat).SetPrec(prec).Set(res)
 }
@@ -290,3 +290,32 @@ func TestBigLogb(t *testing.T) {
 		}
 	})
 }
+
+func TestBigLogMantissaNearTwo(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-70", prec)
+
+	// Mantissas just below 1 (x just below a power of two) converge slowest
+	// ln(255) = ln(3) + ln(5) + ln(17), ln(1023) = ln(3) + ln(11) + ln(31)
+	tests := []struct {
+		x       float64
+		factors []float64
+	}{
+		{255, []float64{3, 5, 17}},
+		{1023, []float64{3, 11, 31}},
+		{0.99609375, []float64{255, 1.0 / 256}},
+	}
+
+	for _, tt := range tests {
+		want := NewBigFloat(0.0, prec)
+		for _, f := range tt.factors {
+			want.Add(want, BigLog(NewBigFloat(f, prec), prec))
+		}
+		for name, log := range map[string]func(*BigFloat, uint) *BigFloat{"dispatch": BigLog, "generic": bigLogGeneric} {
+			diff := new(BigFloat).SetPrec(prec).Sub(log(NewBigFloat(tt.x, prec), prec), want)
+			if diff.Abs(diff).Cmp(tolerance) > 0 {
+				t.Errorf("%s ln(%g) differs from the sum of factor logs by %s", name, tt.x, diff.Text('g', 10))
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"log"
+	"os"
+	"sync"
+)
+
+// Logger receives diagnostic output produced by the package, such as DebugPrintBigVec6.
+// *log.Logger satisfies this interface.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// discardLogger drops all output
+type discardLogger struct{}
+
+func (discardLogger) Printf(string, ...interface{}) {}
+
+var (
+	packageLogger   Logger = log.New(os.Stdout, "", 0)
+	packageLoggerMu sync.RWMutex
+)
+
+// SetLogger replaces the package logger. Passing nil silences all diagnostic output.
+// The default logger writes to standard output without a prefix.
+func SetLogger(l Logger) {
+	if l == nil {
+		l = discardLogger{}
+	}
+	packageLoggerMu.Lock()
+	packageLogger = l
+	packageLoggerMu.Unlock()
+}
+
+// getLogger returns the current package logger
+func getLogger() Logger {
+	packageLoggerMu.RLock()
+	defer packageLoggerMu.RUnlock()
+	return packageLogger
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+// BigMatrix4x4 represents a 4x4 matrix with arbitrary precision, used for
+// affine and projective transforms of homogeneous BigVec4 coordinates
+// M is indexed as M[row][col].
+type BigMatrix4x4 struct {
+	M [4][4]*BigFloat
+}
+
+// newZeroMatrix4x4 creates a 4x4 zero matrix with the given precision
+func newZeroMatrix4x4(prec uint) *BigMatrix4x4 {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+
+	m := &BigMatrix4x4{}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			m.M[i][j] = new(BigFloat).SetPrec(prec)
+		}
+	}
+	return m
+}
+
+// NewIdentityMatrix4x4 creates a 4x4 identity matrix
+func NewIdentityMatrix4x4(prec uint) *BigMatrix4x4 {
+	m := newZeroMatrix4x4(prec)
+	for i := 0; i < 4; i++ {
+		m.M[i][i].SetInt64(1)
+	}
+	return m
+}
+
+// NewTranslationMatrix4x4 creates the affine transform that moves a point by (tx, ty, tz)
+func NewTranslationMatrix4x4(tx, ty, tz *BigFloat, prec uint) *BigMatrix4x4 {
+	m := NewIdentityMatrix4x4(prec)
+	m.M[0][3].Set(tx)
+	m.M[1][3].Set(ty)
+	m.M[2][3].Set(tz)
+	return m
+}
+
+// NewScalingMatrix4x4 creates the affine transform that scales each axis by (sx, sy, sz)
+func NewScalingMatrix4x4(sx, sy, sz *BigFloat, prec uint) *BigMatrix4x4 {
+	m := NewIdentityMatrix4x4(prec)
+	m.M[0][0].Set(sx)
+	m.M[1][1].Set(sy)
+	m.M[2][2].Set(sz)
+	return m
+}
+
+// precOrDefault returns prec, or the precision of the first element if prec is 0
+func (m *BigMatrix4x4) precOrDefault(prec uint) uint {
+	if prec == 0 {
+		return m.M[0][0].Prec()
+	}
+	return prec
+}
+
+// Mul returns the matrix product m * b
+// Each element is accumulated with 32 guard bits and rounded once to prec.
+func (m *BigMatrix4x4) Mul(b *BigMatrix4x4, prec uint) *BigMatrix4x4 {
+	prec = m.precOrDefault(prec)
+
+	workPrec := prec + 32
+	res := newZeroMatrix4x4(prec)
+	sum := new(BigFloat).SetPrec(workPrec)
+	term := new(BigFloat).SetPrec(workPrec)
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			sum.SetInt64(0)
+			for k := 0; k < 4; k++ {
+				term.Mul(m.M[i][k], b.M[k][j])
+				sum.Add(sum, term)
+			}
+			res.M[i][j].Set(sum)
+		}
+	}
+	return res
+}
+
+// MulVec4 returns the matrix-vector product m * v
+func (m *BigMatrix4x4) MulVec4(v *BigVec4, prec uint) *BigVec4 {
+	prec = m.precOrDefault(prec)
+
+	c := []*BigFloat{v.X, v.Y, v.Z, v.W}
+	var out [4]*BigFloat
+	for i := 0; i < 4; i++ {
+		out[i] = BigFloatDotProduct(m.M[i][:], c, prec)
+	}
+	return &BigVec4{X: out[0], Y: out[1], Z: out[2], W: out[3]}
+}
+
+// Transpose returns the transpose of m
+func (m *BigMatrix4x4) Transpose(prec uint) *BigMatrix4x4 {
+	prec = m.precOrDefault(prec)
+
+	res := newZeroMatrix4x4(prec)
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			res.M[j][i].Set(m.M[i][j])
+		}
+	}
+	return res
+}
+
+// Determinant returns det(m)
+// It expands along pairs of rows using the six 2x2 minors of the top and
+// bottom halves, which needs no division.
+func (m *BigMatrix4x4) Determinant(prec uint) *BigFloat {
+	prec = m.precOrDefault(prec)
+	workPrec := prec + 32
+
+	minor := func(r0, r1, c0, c1 int) *BigFloat {
+		a := new(BigFloat).SetPrec(workPrec).Mul(m.M[r0][c0], m.M[r1][c1])
+		b := new(BigFloat).SetPrec(workPrec).Mul(m.M[r0][c1], m.M[r1][c0])
+		return a.Sub(a, b)
+	}
+
+	// Column pairs and the sign of each complementary product
+	pairs := [6][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}
+	signs := [6]int{1, -1, 1, 1, -1, 1}
+
+	det := new(BigFloat).SetPrec(workPrec)
+	term := new(BigFloat).SetPrec(workPrec)
+	for k, p := range pairs {
+		q := pairs[5-k]
+		term.Mul(minor(0, 1, p[0], p[1]), minor(2, 3, q[0], q[1]))
+		if signs[k] < 0 {
+			det.Sub(det, term)
+		} else {
+			det.Add(det, term)
+		}
+	}
+	return new(BigFloat).SetPrec(prec).Set(det)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"testing"
+)
+
+// bigMatrix4x4FromFloat64 builds a BigMatrix4x4 from float64 rows
+func bigMatrix4x4FromFloat64(data [4][4]float64, prec uint) *BigMatrix4x4 {
+	m := &BigMatrix4x4{}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			m.M[i][j] = NewBigFloat(data[i][j], prec)
+		}
+	}
+	return m
+}
+
+func TestBigMatrix4x4Mul(t *testing.T) {
+	prec := uint(256)
+	m := bigMatrix4x4FromFloat64([4][4]float64{
+		{1, 2, 3, 4},
+		{-5, 6, 7, 8},
+		{9, -10, 11, 12},
+		{13, 14, -15, 0.5},
+	}, prec)
+	id := NewIdentityMatrix4x4(prec)
+
+	t.Run("identity", func(t *testing.T) {
+		for name, got := range map[string]*BigMatrix4x4{"I·M": id.Mul(m, prec), "M·I": m.Mul(id, prec)} {
+			for i := 0; i < 4; i++ {
+				for j := 0; j < 4; j++ {
+					if got.M[i][j].Cmp(m.M[i][j]) != 0 {
+						t.Errorf("%s[%d][%d] = %s, want %s", name, i, j, got.M[i][j].Text('g', 20), m.M[i][j].Text('g', 20))
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("transpose_of_product", func(t *testing.T) {
+		// (M·N)ᵀ = Nᵀ·Mᵀ
+		n := bigMatrix4x4FromFloat64([4][4]float64{
+			{0.5, 0, 1, 2},
+			{3, -1, 0, 0},
+			{0, 2, 2, 1},
+			{1, 1, 1, 1},
+		}, prec)
+		lhs := m.Mul(n, prec).Transpose(prec)
+		rhs := n.Transpose(prec).Mul(m.Transpose(prec), prec)
+		for i := 0; i < 4; i++ {
+			for j := 0; j < 4; j++ {
+				if lhs.M[i][j].Cmp(rhs.M[i][j]) != 0 {
+					t.Errorf("(MN)ᵀ[%d][%d] = %s, want %s", i, j, lhs.M[i][j].Text('g', 20), rhs.M[i][j].Text('g', 20))
+				}
+			}
+		}
+	})
+}
+
+func TestBigMatrix4x4Transforms(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+
+	t.Run("translation", func(t *testing.T) {
+		tr := NewTranslationMatrix4x4(NewBigFloat(1.5, prec), NewBigFloat(-2, prec), NewBigFloat(10, prec), prec)
+
+		got := tr.MulVec4(NewBigVec4(1, 2, 3, 1, prec), prec)
+		if got.ToFloat64() != [4]float64{2.5, 0, 13, 1} {
+			t.Errorf("translated point = %v, want [2.5 0 13 1]", got.ToFloat64())
+		}
+
+		// Directions (W = 0) are unaffected by translation
+		dir := tr.MulVec4(NewBigVec4(1, 2, 3, 0, prec), prec)
+		if dir.ToFloat64() != [4]float64{1, 2, 3, 0} {
+			t.Errorf("translated direction = %v, want [1 2 3 0]", dir.ToFloat64())
+		}
+	})
+
+	t.Run("scaling_determinant", func(t *testing.T) {
+		sx, _ := NewBigFloatFromString("1.1", prec)
+		sy, _ := NewBigFloatFromString("-0.3", prec)
+		sz, _ := NewBigFloatFromString("7.77", prec)
+		s := NewScalingMatrix4x4(sx, sy, sz, prec)
+
+		want := new(BigFloat).SetPrec(prec).Mul(sx, sy)
+		want.Mul(want, sz)
+		if got := s.Determinant(prec); !BigFloatEqual(got, want, tol) {
+			t.Errorf("det(scale) = %s, want %s", got.Text('g', 50), want.Text('g', 50))
+		}
+
+		got := s.MulVec4(NewBigVec4(1, 1, 1, 1, prec), prec).ToVec3(prec)
+		if !BigVec3Equal(got, &BigVec3{X: sx, Y: sy, Z: sz}, tol) {
+			t.Errorf("scaled point = %v, want (sx, sy, sz)", got.ToFloat64())
+		}
+	})
+
+	t.Run("determinant_matches_lu", func(t *testing.T) {
+		data := [4][4]float64{
+			{2, -1, 0, 3},
+			{1, 4, -2, 0.5},
+			{0, 3, 5, -1},
+			{7, 0, 1, 2},
+		}
+		rows := make([][]float64, 4)
+		for i := range rows {
+			rows[i] = data[i][:]
+		}
+		n, _ := NewBigMatrixNFromFloat64(rows, prec)
+		lu, _, sign, err := LUDecompose(n, prec)
+		if err != nil {
+			t.Fatalf("LUDecompose: %v", err)
+		}
+		want := NewBigFloat(float64(sign), prec)
+		for i := 0; i < 4; i++ {
+			want.Mul(want, lu.M[i][i])
+		}
+
+		if got := bigMatrix4x4FromFloat64(data, prec).Determinant(prec); !BigFloatEqual(got, want, tol) {
+			t.Errorf("Determinant = %s, want %s", got.Text('g', 50), want.Text('g', 50))
+		}
+	})
+
+	t.Run("composition", func(t *testing.T) {
+		// Scale then translate
+		tr := NewTranslationMatrix4x4(NewBigFloat(1, prec), NewBigFloat(1, prec), NewBigFloat(1, prec), prec)
+		s := NewScalingMatrix4x4(NewBigFloat(2, prec), NewBigFloat(3, prec), NewBigFloat(4, prec), prec)
+		got := tr.Mul(s, prec).MulVec4(NewBigVec4(1, 1, 1, 1, prec), prec)
+		if got.ToFloat64() != [4]float64{3, 4, 5, 1} {
+			t.Errorf("T·S·p = %v, want [3 4 5 1]", got.ToFloat64())
+		}
+	})
+}
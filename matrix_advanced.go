@@ -3,6 +3,11 @@
 
 package bigmath
 
+import (
+	"errors"
+	"fmt"
+)
+
 // BigMatTranspose returns the transpose of a 3x3 matrix
 func BigMatTranspose(m *BigMatrix3x3, prec uint) *BigMatrix3x3 {
 	return getDispatcher().BigMatTransposeImpl(m, prec)
@@ -30,3 +35,275 @@ func BigMatDet(m *BigMatrix3x3, prec uint) *BigFloat {
 func BigMatInverse(m *BigMatrix3x3, prec uint) (*BigMatrix3x3, error) {
 	return getDispatcher().BigMatInverseImpl(m, prec)
 }
+
+// BigMatAdd adds two 3x3 matrices element-wise: result = a + b
+func BigMatAdd(a, b *BigMatrix3x3, prec uint) *BigMatrix3x3 {
+	return getDispatcher().BigMatAddImpl(a, b, prec)
+}
+
+// BigMatSub subtracts two 3x3 matrices element-wise: result = a - b
+func BigMatSub(a, b *BigMatrix3x3, prec uint) *BigMatrix3x3 {
+	return getDispatcher().BigMatSubImpl(a, b, prec)
+}
+
+// BigMatTrace returns the sum of the diagonal elements of a 3x3 matrix
+func BigMatTrace(m *BigMatrix3x3, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = m.M[0][0].Prec()
+	}
+
+	// Sum with guard bits so that the result is rounded only once
+	sum := new(BigFloat).SetPrec(prec+32).Add(m.M[0][0], m.M[1][1])
+	sum.Add(sum, m.M[2][2])
+	return new(BigFloat).SetPrec(prec).Set(sum)
+}
+
+// BigMatScale multiplies every element of a 3x3 matrix by s
+func BigMatScale(m *BigMatrix3x3, s *BigFloat, prec uint) *BigMatrix3x3 {
+	if prec == 0 {
+		prec = m.M[0][0].Prec()
+	}
+
+	result := &BigMatrix3x3{}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			result.M[i][j] = new(BigFloat).SetPrec(prec).Mul(m.M[i][j], s)
+		}
+	}
+	return result
+}
+
+// BigMatIsOrthogonal reports whether MᵀM = I holds element-wise within tol
+func BigMatIsOrthogonal(m *BigMatrix3x3, tol *BigFloat, prec uint) bool {
+	_, _, diff := bigMatOrthogonalityDefect(m, tol, prec)
+	return diff == nil
+}
+
+// bigMatOrthogonalityDefect returns the first element (i, j) of MᵀM that
+// differs from the identity by more than tol, with that difference, or a nil
+// difference if M is orthogonal within tol
+func bigMatOrthogonalityDefect(m *BigMatrix3x3, tol *BigFloat, prec uint) (i, j int, diff *BigFloat) {
+	if prec == 0 {
+		prec = m.M[0][0].Prec()
+	}
+
+	workPrec := prec + 32
+	mtm := BigMatMulMat(BigMatTranspose(m, workPrec), m, workPrec)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			diff := new(BigFloat).SetPrec(workPrec).Set(mtm.M[i][j])
+			if i == j {
+				diff.Sub(diff, NewBigFloat(1.0, workPrec))
+			}
+			if diff.Abs(diff).Cmp(tol) > 0 {
+				return i, j, diff
+			}
+		}
+	}
+	return 0, 0, nil
+}
+
+// IsRotationMatrix reports whether m is a proper rotation: MᵀM = I
+// element-wise within tol and det(M) = +1 within tol
+func IsRotationMatrix(m *BigMatrix3x3, tol *BigFloat, prec uint) bool {
+	return ValidateRotationMatrix(m, tol, prec) == nil
+}
+
+// ValidateRotationMatrix checks the same conditions as IsRotationMatrix and
+// returns an error describing the first one that fails
+// Reflections and other improper orthogonal matrices fail with det = -1.
+func ValidateRotationMatrix(m *BigMatrix3x3, tol *BigFloat, prec uint) error {
+	if prec == 0 {
+		prec = m.M[0][0].Prec()
+	}
+
+	if i, j, diff := bigMatOrthogonalityDefect(m, tol, prec); diff != nil {
+		return fmt.Errorf("matrix is not orthogonal: (MᵀM)[%d][%d] differs from the identity by %s", i, j, diff.Text('g', 10))
+	}
+
+	workPrec := prec + 32
+	det := BigMatDet(m, workPrec)
+	diff := new(BigFloat).SetPrec(workPrec).Sub(det, NewBigFloat(1.0, workPrec))
+	if diff.Abs(diff).Cmp(tol) > 0 {
+		return fmt.Errorf("matrix has determinant %s, want +1", det.Text('g', 10))
+	}
+	return nil
+}
+
+// BigMatEigenvaluesSymmetric computes the three real eigenvalues of a
+// symmetric 3x3 matrix, sorted in descending order.
+// The characteristic cubic is solved in trigonometric form: with q = tr(M)/3,
+// p = √(tr((M-qI)²)/6) and B = (M-qI)/p, the eigenvalues are
+// q + 2p·cos(acos(det(B)/2)/3 + 2πk/3).
+// Returns an error if M differs from its transpose by more than 2^(16-prec)
+// relative to its largest element.
+func BigMatEigenvaluesSymmetric(m *BigMatrix3x3, prec uint) ([3]*BigFloat, error) {
+	if prec == 0 {
+		prec = m.M[0][0].Prec()
+	}
+
+	scale := new(BigFloat)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if cmpAbs(m.M[i][j], scale) > 0 {
+				scale.Abs(m.M[i][j])
+			}
+		}
+	}
+	symTol := new(BigFloat).SetMantExp(scale, 16-int(prec))
+	for i := 0; i < 3; i++ {
+		for j := i + 1; j < 3; j++ {
+			diff := new(BigFloat).SetPrec(prec+32).Sub(m.M[i][j], m.M[j][i])
+			if cmpAbs(diff, symTol) > 0 {
+				return [3]*BigFloat{}, errors.New("matrix is not symmetric")
+			}
+		}
+	}
+
+	// acos loses about half of the working bits when det(B)/2 is close to ±1,
+	// which happens for (nearly) repeated eigenvalues
+	workPrec := 2*prec + 32
+
+	// Off-diagonal energy; zero means the matrix is already diagonal
+	p1 := new(BigFloat).SetPrec(workPrec)
+	for _, idx := range [][2]int{{0, 1}, {0, 2}, {1, 2}} {
+		p1.Add(p1, new(BigFloat).SetPrec(workPrec).Mul(m.M[idx[0]][idx[1]], m.M[idx[0]][idx[1]]))
+	}
+
+	eig := [3]*BigFloat{}
+	if p1.Sign() == 0 {
+		for i := 0; i < 3; i++ {
+			eig[i] = new(BigFloat).SetPrec(workPrec).Set(m.M[i][i])
+		}
+	} else {
+		q := new(BigFloat).SetPrec(workPrec).Add(m.M[0][0], m.M[1][1])
+		q.Add(q, m.M[2][2])
+		q.Quo(q, NewBigFloat(3.0, workPrec))
+
+		// p = √(((a₀₀-q)² + (a₁₁-q)² + (a₂₂-q)² + 2p1)/6)
+		shifted := NewIdentityMatrix(workPrec)
+		p2 := new(BigFloat).SetPrec(workPrec).Add(p1, p1)
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				shifted.M[i][j].Set(m.M[i][j])
+			}
+			shifted.M[i][i].Sub(shifted.M[i][i], q)
+			p2.Add(p2, new(BigFloat).SetPrec(workPrec).Mul(shifted.M[i][i], shifted.M[i][i]))
+		}
+		p2.Quo(p2, NewBigFloat(6.0, workPrec))
+		p := new(BigFloat).SetPrec(workPrec).Sqrt(p2)
+
+		// r = det((M - qI)/p)/2, clamped to [-1, 1] against rounding
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				shifted.M[i][j].Quo(shifted.M[i][j], p)
+			}
+		}
+		r := BigMatDet(shifted, workPrec)
+		r.SetMantExp(r, -1)
+		r = BigClamp(r, NewBigFloat(-1.0, workPrec), NewBigFloat(1.0, workPrec), workPrec)
+
+		phi := BigAcos(r, workPrec)
+		phi.Quo(phi, NewBigFloat(3.0, workPrec))
+		twoP := new(BigFloat).SetPrec(workPrec).Add(p, p)
+
+		// λ₁ = q + 2p·cos(φ) ≥ λ₂ ≥ λ₃ = q + 2p·cos(φ + 2π/3), λ₂ = 3q - λ₁ - λ₃
+		thirdTurn := BigTwoPI(workPrec)
+		thirdTurn.Quo(thirdTurn, NewBigFloat(3.0, workPrec))
+		eig[0] = new(BigFloat).SetPrec(workPrec).Mul(twoP, BigCos(phi, workPrec))
+		eig[0].Add(eig[0], q)
+		eig[2] = new(BigFloat).SetPrec(workPrec).Mul(twoP, BigCos(new(BigFloat).SetPrec(workPrec).Add(phi, thirdTurn), workPrec))
+		eig[2].Add(eig[2], q)
+		eig[1] = new(BigFloat).SetPrec(workPrec).Mul(q, NewBigFloat(3.0, workPrec))
+		eig[1].Sub(eig[1], eig[0])
+		eig[1].Sub(eig[1], eig[2])
+	}
+
+	// Sort descending; the trigonometric form is already ordered, the
+	// diagonal shortcut is not
+	for i := 0; i < 2; i++ {
+		for j := i + 1; j < 3; j++ {
+			if eig[j].Cmp(eig[i]) > 0 {
+				eig[i], eig[j] = eig[j], eig[i]
+			}
+		}
+	}
+
+	for i := range eig {
+		eig[i] = new(BigFloat).SetPrec(prec).Set(eig[i])
+	}
+	return eig, nil
+}
+
+// bigMatFromAxisAngle builds the rotation by angle about the unit vector axis
+// using Rodrigues' formula R = I + sinθ·K + (1-cosθ)·K², where K is the
+// cross-product matrix of axis
+func bigMatFromAxisAngle(axis *BigVec3, angle *BigFloat, prec uint) *BigMatrix3x3 {
+	s, c := BigSinCos(angle, prec)
+	oneMinusC := new(BigFloat).SetPrec(prec).Sub(NewBigFloat(1.0, prec), c)
+
+	neg := func(x *BigFloat) *BigFloat { return new(BigFloat).SetPrec(prec).Neg(x) }
+	zero := NewBigFloat(0.0, prec)
+	k := [3][3]*BigFloat{
+		{zero, neg(axis.Z), axis.Y},
+		{axis.Z, zero, neg(axis.X)},
+		{neg(axis.Y), axis.X, zero},
+	}
+
+	result := NewIdentityMatrix(prec)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			// (K²)ᵢⱼ = Σ Kᵢₗ Kₗⱼ
+			k2 := NewBigFloat(0.0, prec)
+			for l := 0; l < 3; l++ {
+				k2.Add(k2, new(BigFloat).SetPrec(prec).Mul(k[i][l], k[l][j]))
+			}
+			k2.Mul(k2, oneMinusC)
+			term := new(BigFloat).SetPrec(prec).Mul(s, k[i][j])
+			result.M[i][j].Add(result.M[i][j], term)
+			result.M[i][j].Add(result.M[i][j], k2)
+		}
+	}
+	return result
+}
+
+// bigMatToAxisAngle extracts the unit rotation axis and the angle in [0, π]
+// of a rotation matrix. The identity returns the zero axis and angle 0.
+func bigMatToAxisAngle(m *BigMatrix3x3, prec uint) (*BigVec3, *BigFloat) {
+	// 2sinθ·axis = (R₃₂ - R₂₃, R₁₃ - R₃₁, R₂₁ - R₁₂)
+	w := &BigVec3{
+		X: new(BigFloat).SetPrec(prec).Sub(m.M[2][1], m.M[1][2]),
+		Y: new(BigFloat).SetPrec(prec).Sub(m.M[0][2], m.M[2][0]),
+		Z: new(BigFloat).SetPrec(prec).Sub(m.M[1][0], m.M[0][1]),
+	}
+	twoSin := BigVec3Magnitude(w, prec)
+
+	// 2cosθ = trace - 1
+	twoCos := new(BigFloat).SetPrec(prec).Add(m.M[0][0], m.M[1][1])
+	twoCos.Add(twoCos, m.M[2][2])
+	twoCos.Sub(twoCos, NewBigFloat(1.0, prec))
+
+	angle := BigAtan2(twoSin, twoCos, prec)
+
+	// Near θ = π the antisymmetric part vanishes; recover the axis from
+	// the symmetric part (R + I)/2 ≈ axis·axisᵀ instead
+	threshold := new(BigFloat).SetMantExp(NewBigFloat(1.0, prec), -int(prec/2))
+	if twoCos.Sign() < 0 && twoSin.Cmp(threshold) < 0 {
+		best := 0
+		for i := 1; i < 3; i++ {
+			if m.M[i][i].Cmp(m.M[best][best]) > 0 {
+				best = i
+			}
+		}
+		col := &BigVec3{
+			X: new(BigFloat).SetPrec(prec).Set(m.M[0][best]),
+			Y: new(BigFloat).SetPrec(prec).Set(m.M[1][best]),
+			Z: new(BigFloat).SetPrec(prec).Set(m.M[2][best]),
+		}
+		diag := [3]*BigFloat{col.X, col.Y, col.Z}[best]
+		diag.Add(diag, NewBigFloat(1.0, prec))
+		return BigVec3Normalize(col, prec), angle
+	}
+
+	return BigVec3Normalize(w, prec), angle
+}
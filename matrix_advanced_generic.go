@@ -35,11 +35,13 @@ func bigMatMulMatGeneric(m1, m2 *BigMatrix3x3, prec uint) *BigMatrix3x3 {
 	}
 
 	// Compute each element: result[i][j] = sum_k(m1[i][k] * m2[k][j])
+	product := getScratch(prec)
+	defer putScratch(product)
 	for i := 0; i < 3; i++ {
 		for j := 0; j < 3; j++ {
 			sum := NewBigFloat(0.0, prec)
 			for k := 0; k < 3; k++ {
-				product := new(BigFloat).SetPrec(prec).Mul(m1.M[i][k], m2.M[k][j])
+				product.Mul(m1.M[i][k], m2.M[k][j])
 				sum.Add(sum, product)
 			}
 			result.M[i][j] = sum
@@ -150,3 +152,33 @@ func bigMatInverseGeneric(m *BigMatrix3x3, prec uint) (*BigMatrix3x3, error) {
 
 	return result, nil
 }
+
+// bigMatAddGeneric adds two 3x3 matrices element-wise using pure Go implementation
+func bigMatAddGeneric(a, b *BigMatrix3x3, prec uint) *BigMatrix3x3 {
+	if prec == 0 {
+		prec = a.M[0][0].Prec()
+	}
+
+	result := &BigMatrix3x3{}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			result.M[i][j] = new(BigFloat).SetPrec(prec).Add(a.M[i][j], b.M[i][j])
+		}
+	}
+	return result
+}
+
+// bigMatSubGeneric subtracts two 3x3 matrices element-wise using pure Go implementation
+func bigMatSubGeneric(a, b *BigMatrix3x3, prec uint) *BigMatrix3x3 {
+	if prec == 0 {
+		prec = a.M[0][0].Prec()
+	}
+
+	result := &BigMatrix3x3{}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			result.M[i][j] = new(BigFloat).SetPrec(prec).Sub(a.M[i][j], b.M[i][j])
+		}
+	}
+	return result
+}
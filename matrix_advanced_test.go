@@ -5,6 +5,7 @@ package bigmath
 
 import (
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -470,3 +471,267 @@ func TestBigMatInverse(t *testing.T) {
 		}
 	})
 }
+
+func TestBigMatAddSub(t *testing.T) {
+	prec := uint(256)
+
+	third := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1, prec), NewBigFloat(3, prec))
+	a := bigMatrix3x3FromFloat64([3][3]float64{{1.5, -2, 3}, {4, 5.25, -6}, {7, 8, 9.125}}, prec)
+	a.M[1][1] = third
+	b := bigMatrix3x3FromFloat64([3][3]float64{{-0.5, 2, 1}, {0, 3, 6}, {-7, 1e10, 1e-10}}, prec)
+
+	t.Run("commutative", func(t *testing.T) {
+		ab := BigMatAdd(a, b, prec)
+		ba := BigMatAdd(b, a, prec)
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				if ab.M[i][j].Cmp(ba.M[i][j]) != 0 {
+					t.Errorf("(A+B)[%d][%d] = %s, (B+A)[%d][%d] = %s", i, j, ab.M[i][j].Text('g', 30), i, j, ba.M[i][j].Text('g', 30))
+				}
+			}
+		}
+	})
+
+	t.Run("self_subtraction_is_zero", func(t *testing.T) {
+		zero := BigMatSub(a, a, prec)
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				if zero.M[i][j].Sign() != 0 {
+					t.Errorf("(A-A)[%d][%d] = %s, want exactly 0", i, j, zero.M[i][j].Text('g', 30))
+				}
+			}
+		}
+	})
+
+	t.Run("values_and_precision", func(t *testing.T) {
+		sum := BigMatAdd(a, b, 128)
+		diff := BigMatSub(a, b, 0)
+		if sum.M[0][0].Cmp(NewBigFloat(1.0, prec)) != 0 || diff.M[0][0].Cmp(NewBigFloat(2.0, prec)) != 0 {
+			t.Errorf("A+B = %s, A-B = %s at [0][0], want 1 and 2", sum.M[0][0].Text('g', 10), diff.M[0][0].Text('g', 10))
+		}
+		if sum.M[2][2].Prec() != 128 || diff.M[2][2].Prec() != prec {
+			t.Errorf("precision = %d and %d, want 128 and %d", sum.M[2][2].Prec(), diff.M[2][2].Prec(), prec)
+		}
+	})
+}
+
+func TestBigMatTrace(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	m := bigMatrix3x3FromFloat64([3][3]float64{{1.5, 2, 3}, {4, -2.25, 6}, {7, 8, 10}}, prec)
+	if got := BigMatTrace(m, prec); got.Cmp(NewBigFloat(9.25, prec)) != 0 {
+		t.Errorf("BigMatTrace = %s, want 9.25", got.Text('g', 20))
+	}
+
+	// trace(A·B) = trace(B·A) for arbitrary matrices
+	for seed := 1; seed <= 5; seed++ {
+		var a, b [3][3]float64
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				a[i][j] = math.Sin(float64(seed*10+i*3+j)) * 10
+				b[i][j] = math.Cos(float64(seed*7+i*3+j)) * 3
+			}
+		}
+		ma := bigMatrix3x3FromFloat64(a, prec)
+		mb := bigMatrix3x3FromFloat64(b, prec)
+		ab := BigMatTrace(BigMatMulMat(ma, mb, prec), prec)
+		ba := BigMatTrace(BigMatMulMat(mb, ma, prec), prec)
+		diff := new(BigFloat).SetPrec(prec).Sub(ab, ba)
+		if diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("seed %d: trace(A·B) = %s, trace(B·A) = %s", seed, ab.Text('g', 40), ba.Text('g', 40))
+		}
+	}
+}
+
+func TestBigMatScale(t *testing.T) {
+	m := bigMatrix3x3FromFloat64([3][3]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, 64)
+
+	for _, prec := range []uint{53, 128, 512} {
+		result := BigMatScale(m, NewBigFloat(-0.5, prec), prec)
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				want := NewBigFloat(-0.5*float64(i*3+j+1), prec)
+				if result.M[i][j].Cmp(want) != 0 {
+					t.Errorf("prec %d: BigMatScale[%d][%d] = %s, want %s", prec, i, j, result.M[i][j].Text('g', 20), want.Text('g', 20))
+				}
+				if result.M[i][j].Prec() != prec {
+					t.Errorf("prec %d: BigMatScale[%d][%d] precision = %d", prec, i, j, result.M[i][j].Prec())
+				}
+			}
+		}
+	}
+
+	if m.M[0][0].Cmp(NewBigFloat(1, 64)) != 0 {
+		t.Error("BigMatScale modified its input")
+	}
+}
+
+func TestBigMatIsOrthogonal(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	rotation := CreateRotationMatrix([3]*BigFloat{NewBigFloat(0.7, prec), NewBigFloat(0, prec), NewBigFloat(0, prec)}, prec)
+	reflection := NewIdentityMatrix(prec)
+	reflection.M[1][1] = NewBigFloat(-1, prec)
+	scaled := NewIdentityMatrix(prec)
+	scaled.M[0][0] = NewBigFloat(2, prec)
+
+	tests := []struct {
+		name string
+		m    *BigMatrix3x3
+		want bool
+	}{
+		{"identity", NewIdentityMatrix(prec), true},
+		{"rotation", rotation, true},
+		{"reflection", reflection, true},
+		{"scaled", scaled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BigMatIsOrthogonal(tt.m, tol, prec); got != tt.want {
+				t.Errorf("BigMatIsOrthogonal = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRotationMatrix(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+
+	axisAngle := CreateRotationMatrixAxisAngle(NewBigVec3(1, -2, 0.5, prec), NewBigFloat(2.1, prec), prec)
+	reflection := NewIdentityMatrix(prec)
+	reflection.M[2][2] = NewBigFloat(-1, prec)
+	shear := NewIdentityMatrix(prec)
+	shear.M[0][1] = NewBigFloat(0.25, prec)
+	nearly := NewIdentityMatrix(prec)
+	nearly.M[1][1], _ = NewBigFloatFromString("1.00000000000000000001", prec)
+
+	tests := []struct {
+		name    string
+		m       *BigMatrix3x3
+		want    bool
+		errText string
+	}{
+		{"identity", NewIdentityMatrix(prec), true, ""},
+		{"axis_angle", axisAngle, true, ""},
+		{"reflection", reflection, false, "determinant"},
+		{"shear", shear, false, "not orthogonal"},
+		{"slightly_scaled", nearly, false, "not orthogonal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRotationMatrix(tt.m, tol, prec); got != tt.want {
+				t.Errorf("IsRotationMatrix = %v, want %v", got, tt.want)
+			}
+			err := ValidateRotationMatrix(tt.m, tol, prec)
+			if tt.want {
+				if err != nil {
+					t.Errorf("ValidateRotationMatrix: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errText) {
+				t.Errorf("ValidateRotationMatrix error = %v, want one mentioning %q", err, tt.errText)
+			}
+		})
+	}
+}
+
+// bigMatrix3x3FromFloat64 builds a BigMatrix3x3 from row-major float64 values
+func bigMatrix3x3FromFloat64(rows [3][3]float64, prec uint) *BigMatrix3x3 {
+	m := &BigMatrix3x3{}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m.M[i][j] = NewBigFloat(rows[i][j], prec)
+		}
+	}
+	return m
+}
+
+func TestBigMatEigenvaluesSymmetric(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+	sqrt2 := BigSqrt(NewBigFloat(2.0, prec), prec)
+
+	tests := []struct {
+		name     string
+		m        [3][3]float64
+		expected [3]*BigFloat
+	}{
+		{
+			"diagonal",
+			[3][3]float64{{1, 0, 0}, {0, 5, 0}, {0, 0, -2}},
+			[3]*BigFloat{NewBigFloat(5, prec), NewBigFloat(1, prec), NewBigFloat(-2, prec)},
+		},
+		{
+			// Eigenvalues 2+√2, 2, 2-√2
+			"tridiagonal",
+			[3][3]float64{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}},
+			[3]*BigFloat{
+				new(BigFloat).SetPrec(prec).Add(NewBigFloat(2, prec), sqrt2),
+				NewBigFloat(2, prec),
+				new(BigFloat).SetPrec(prec).Sub(NewBigFloat(2, prec), sqrt2),
+			},
+		},
+		{
+			// Repeated eigenvalue 3
+			"repeated",
+			[3][3]float64{{2, 1, 0}, {1, 2, 0}, {0, 0, 3}},
+			[3]*BigFloat{NewBigFloat(3, prec), NewBigFloat(3, prec), NewBigFloat(1, prec)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eig, err := BigMatEigenvaluesSymmetric(bigMatrix3x3FromFloat64(tt.m, prec), prec)
+			if err != nil {
+				t.Fatalf("BigMatEigenvaluesSymmetric error: %v", err)
+			}
+			for i := range eig {
+				if eig[i].Prec() != prec {
+					t.Errorf("eigenvalue %d precision = %d, want %d", i, eig[i].Prec(), prec)
+				}
+				diff := new(BigFloat).SetPrec(prec).Sub(eig[i], tt.expected[i])
+				if diff.Abs(diff).Cmp(tol) > 0 {
+					t.Errorf("eigenvalue %d = %s, want %s", i, eig[i].Text('g', 50), tt.expected[i].Text('g', 50))
+				}
+			}
+		})
+	}
+
+	t.Run("trace_and_determinant", func(t *testing.T) {
+		m := bigMatrix3x3FromFloat64([3][3]float64{{4, 1, 2}, {1, 3, 0.5}, {2, 0.5, 5}}, prec)
+		eig, err := BigMatEigenvaluesSymmetric(m, prec)
+		if err != nil {
+			t.Fatalf("BigMatEigenvaluesSymmetric error: %v", err)
+		}
+		if eig[0].Cmp(eig[1]) < 0 || eig[1].Cmp(eig[2]) < 0 {
+			t.Errorf("eigenvalues not sorted descending: %s, %s, %s", eig[0].Text('g', 20), eig[1].Text('g', 20), eig[2].Text('g', 20))
+		}
+
+		sum := new(BigFloat).SetPrec(prec).Add(eig[0], eig[1])
+		sum.Add(sum, eig[2])
+		diff := new(BigFloat).SetPrec(prec).Sub(sum, NewBigFloat(12, prec))
+		if diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("sum of eigenvalues = %s, want trace 12", sum.Text('g', 50))
+		}
+
+		prod := new(BigFloat).SetPrec(prec).Mul(eig[0], eig[1])
+		prod.Mul(prod, eig[2])
+		diff.Sub(prod, BigMatDet(m, prec))
+		if diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("product of eigenvalues = %s, want det %s", prod.Text('g', 50), BigMatDet(m, prec).Text('g', 50))
+		}
+	})
+
+	t.Run("not_symmetric", func(t *testing.T) {
+		m := bigMatrix3x3FromFloat64([3][3]float64{{1, 2, 0}, {0, 1, 0}, {0, 0, 1}}, prec)
+		if _, err := BigMatEigenvaluesSymmetric(m, prec); err == nil {
+			t.Error("BigMatEigenvaluesSymmetric on a non-symmetric matrix expected error")
+		}
+	})
+}
@@ -0,0 +1,386 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// BigMatrixN represents a general Rows×Cols matrix with BigFloat elements
+// M is indexed as M[row][col]
+type BigMatrixN struct {
+	Rows int
+	Cols int
+	M    [][]*BigFloat
+}
+
+// NewBigMatrixN creates a rows×cols zero matrix with the given precision
+func NewBigMatrixN(rows, cols int, prec uint) (*BigMatrixN, error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, fmt.Errorf("invalid matrix dimensions %dx%d: rows and cols must be positive", rows, cols)
+	}
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+
+	m := &BigMatrixN{Rows: rows, Cols: cols, M: make([][]*BigFloat, rows)}
+	for i := range m.M {
+		m.M[i] = make([]*BigFloat, cols)
+		for j := range m.M[i] {
+			m.M[i][j] = new(BigFloat).SetPrec(prec)
+		}
+	}
+	return m, nil
+}
+
+// NewIdentityMatrixN creates the n×n identity matrix with the given precision
+func NewIdentityMatrixN(n int, prec uint) (*BigMatrixN, error) {
+	m, err := NewBigMatrixN(n, n, prec)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		m.M[i][i].SetInt64(1)
+	}
+	return m, nil
+}
+
+// NewBigMatrixNFromFloat64 creates a matrix from rows of float64 values
+// All rows must have the same, non-zero length.
+func NewBigMatrixNFromFloat64(data [][]float64, prec uint) (*BigMatrixN, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("invalid matrix dimensions 0x0: rows and cols must be positive")
+	}
+	m, err := NewBigMatrixN(len(data), len(data[0]), prec)
+	if err != nil {
+		return nil, err
+	}
+	for i, row := range data {
+		if len(row) != m.Cols {
+			return nil, fmt.Errorf("row %d has %d elements, expected %d", i, len(row), m.Cols)
+		}
+		for j, v := range row {
+			m.M[i][j] = NewBigFloat(v, prec)
+		}
+	}
+	return m, nil
+}
+
+// cmpAbs compares |x| and |y| and returns -1, 0 or +1
+func cmpAbs(x, y *BigFloat) int {
+	var ax, ay BigFloat
+	return ax.Abs(x).Cmp(ay.Abs(y))
+}
+
+// precOrDefault returns prec, or the precision of the first element if prec is 0
+func (m *BigMatrixN) precOrDefault(prec uint) uint {
+	if prec == 0 {
+		return m.M[0][0].Prec()
+	}
+	return prec
+}
+
+// Add returns the element-wise sum m + b
+// Returns an error if the dimensions differ.
+func (m *BigMatrixN) Add(b *BigMatrixN, prec uint) (*BigMatrixN, error) {
+	if m.Rows != b.Rows || m.Cols != b.Cols {
+		return nil, fmt.Errorf("cannot add %dx%d and %dx%d matrices: dimensions differ", m.Rows, m.Cols, b.Rows, b.Cols)
+	}
+	prec = m.precOrDefault(prec)
+
+	res, _ := NewBigMatrixN(m.Rows, m.Cols, prec)
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			res.M[i][j].Add(m.M[i][j], b.M[i][j])
+		}
+	}
+	return res, nil
+}
+
+// Sub returns the element-wise difference m - b
+// Returns an error if the dimensions differ.
+func (m *BigMatrixN) Sub(b *BigMatrixN, prec uint) (*BigMatrixN, error) {
+	if m.Rows != b.Rows || m.Cols != b.Cols {
+		return nil, fmt.Errorf("cannot subtract %dx%d and %dx%d matrices: dimensions differ", m.Rows, m.Cols, b.Rows, b.Cols)
+	}
+	prec = m.precOrDefault(prec)
+
+	res, _ := NewBigMatrixN(m.Rows, m.Cols, prec)
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			res.M[i][j].Sub(m.M[i][j], b.M[i][j])
+		}
+	}
+	return res, nil
+}
+
+// Mul returns the matrix product m * b
+// Each element is accumulated at extra working precision and rounded once.
+// Products with more than 32 rows are computed in parallel row bands.
+// Returns an error if m.Cols != b.Rows.
+func (m *BigMatrixN) Mul(b *BigMatrixN, prec uint) (*BigMatrixN, error) {
+	if m.Cols != b.Rows {
+		return nil, fmt.Errorf("cannot multiply %dx%d by %dx%d matrix: inner dimensions %d and %d differ", m.Rows, m.Cols, b.Rows, b.Cols, m.Cols, b.Rows)
+	}
+
+	workers := 1
+	if m.Rows > matrixNParallelThreshold {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return m.mul(b, m.precOrDefault(prec), workers), nil
+}
+
+// matrixNParallelThreshold is the row count above which Mul splits the output
+// rows across GOMAXPROCS goroutines
+const matrixNParallelThreshold = 32
+
+// mul computes m * b with the output rows split into contiguous bands, one per
+// worker. Each worker writes only its own rows, so no locking is needed, and
+// every element is summed in the same order as in a serial product.
+func (m *BigMatrixN) mul(b *BigMatrixN, prec uint, workers int) *BigMatrixN {
+	res, _ := NewBigMatrixN(m.Rows, b.Cols, prec)
+	if workers > m.Rows {
+		workers = m.Rows
+	}
+	if workers <= 1 {
+		m.mulRows(b, res, 0, m.Rows, prec+32)
+		return res
+	}
+
+	var wg sync.WaitGroup
+	band := (m.Rows + workers - 1) / workers
+	for lo := 0; lo < m.Rows; lo += band {
+		hi := lo + band
+		if hi > m.Rows {
+			hi = m.Rows
+		}
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			m.mulRows(b, res, lo, hi, prec+32)
+		}(lo, hi)
+	}
+	wg.Wait()
+	return res
+}
+
+// mulRows sets rows [lo, hi) of res to the corresponding rows of m * b,
+// accumulating each element at workPrec
+func (m *BigMatrixN) mulRows(b, res *BigMatrixN, lo, hi int, workPrec uint) {
+	sum := new(BigFloat).SetPrec(workPrec)
+	term := new(BigFloat).SetPrec(workPrec)
+	for i := lo; i < hi; i++ {
+		for j := 0; j < b.Cols; j++ {
+			sum.SetInt64(0)
+			for k := 0; k < m.Cols; k++ {
+				term.Mul(m.M[i][k], b.M[k][j])
+				sum.Add(sum, term)
+			}
+			res.M[i][j].Set(sum)
+		}
+	}
+}
+
+// Transpose returns the Cols×Rows transpose of m
+func (m *BigMatrixN) Transpose(prec uint) *BigMatrixN {
+	prec = m.precOrDefault(prec)
+
+	res, _ := NewBigMatrixN(m.Cols, m.Rows, prec)
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			res.M[j][i].Set(m.M[i][j])
+		}
+	}
+	return res
+}
+
+// Scale returns s * m
+func (m *BigMatrixN) Scale(s *BigFloat, prec uint) *BigMatrixN {
+	prec = m.precOrDefault(prec)
+
+	res, _ := NewBigMatrixN(m.Rows, m.Cols, prec)
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			res.M[i][j].Mul(m.M[i][j], s)
+		}
+	}
+	return res
+}
+
+// LUDecompose factors the square matrix a as P·a = L·U using Gaussian
+// elimination with partial pivoting. The factors are returned packed in lu:
+// U on and above the diagonal and the multipliers of the unit lower
+// triangular L below it. pivots[i] is the row of a that was moved to row i,
+// and sign is the parity (+1 or -1) of that permutation, so
+// det(a) = sign·∏ lu[i][i].
+// Returns an error if a is not square or if a pivot is zero within a
+// tolerance of 2^-prec relative to the largest element of a.
+func LUDecompose(a *BigMatrixN, prec uint) (lu *BigMatrixN, pivots []int, sign int, err error) {
+	prec = a.precOrDefault(prec)
+
+	work, pivots, sign, err := luDecompose(a, prec+32, prec)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	lu, _ = NewBigMatrixN(a.Rows, a.Cols, prec)
+	for i := range work.M {
+		for j := range work.M[i] {
+			lu.M[i][j].Set(work.M[i][j])
+		}
+	}
+	return lu, pivots, sign, nil
+}
+
+// luDecompose performs the elimination of LUDecompose at workPrec and
+// rejects pivots below max|a|·2^-prec
+func luDecompose(a *BigMatrixN, workPrec, prec uint) (*BigMatrixN, []int, int, error) {
+	if a.Rows != a.Cols {
+		return nil, nil, 0, fmt.Errorf("cannot LU-decompose %dx%d matrix: matrix must be square", a.Rows, a.Cols)
+	}
+	n := a.Rows
+
+	lu, _ := NewBigMatrixN(n, n, workPrec)
+	scale := new(BigFloat).SetPrec(workPrec)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			lu.M[i][j].Set(a.M[i][j])
+			if cmpAbs(lu.M[i][j], scale) > 0 {
+				scale.Abs(lu.M[i][j])
+			}
+		}
+	}
+	tol := new(BigFloat).SetPrec(workPrec).SetMantExp(scale, -int(prec))
+
+	pivots := make([]int, n)
+	for i := range pivots {
+		pivots[i] = i
+	}
+	sign := 1
+
+	factor := new(BigFloat).SetPrec(workPrec)
+	term := new(BigFloat).SetPrec(workPrec)
+	for k := 0; k < n; k++ {
+		// Partial pivoting: bring the largest remaining entry of column k up
+		p := k
+		for i := k + 1; i < n; i++ {
+			if cmpAbs(lu.M[i][k], lu.M[p][k]) > 0 {
+				p = i
+			}
+		}
+		if scale.Sign() == 0 || cmpAbs(lu.M[p][k], tol) <= 0 {
+			return nil, nil, 0, fmt.Errorf("matrix is singular: pivot %d is zero within tolerance", k)
+		}
+		if p != k {
+			lu.M[p], lu.M[k] = lu.M[k], lu.M[p]
+			pivots[p], pivots[k] = pivots[k], pivots[p]
+			sign = -sign
+		}
+
+		for i := k + 1; i < n; i++ {
+			factor.Quo(lu.M[i][k], lu.M[k][k])
+			lu.M[i][k].Set(factor)
+			for j := k + 1; j < n; j++ {
+				term.Mul(factor, lu.M[k][j])
+				lu.M[i][j].Sub(lu.M[i][j], term)
+			}
+		}
+	}
+
+	return lu, pivots, sign, nil
+}
+
+// SolveBig solves the linear system a·x = b for x using LU decomposition
+// with partial pivoting. The elimination and substitution are carried out
+// with 32 guard bits and the solution is rounded to prec.
+// Returns an error if a is not square, if len(b) does not match, or if a is
+// singular.
+func SolveBig(a *BigMatrixN, b []*BigFloat, prec uint) ([]*BigFloat, error) {
+	if len(b) != a.Rows {
+		return nil, fmt.Errorf("cannot solve %dx%d system: right-hand side has %d elements", a.Rows, a.Cols, len(b))
+	}
+	prec = a.precOrDefault(prec)
+	workPrec := prec + 32
+
+	lu, pivots, _, err := luDecompose(a, workPrec, prec)
+	if err != nil {
+		return nil, err
+	}
+	n := a.Rows
+
+	// Forward substitution L·y = P·b
+	y := make([]*BigFloat, n)
+	term := new(BigFloat).SetPrec(workPrec)
+	for i := 0; i < n; i++ {
+		y[i] = new(BigFloat).SetPrec(workPrec).Set(b[pivots[i]])
+		for j := 0; j < i; j++ {
+			term.Mul(lu.M[i][j], y[j])
+			y[i].Sub(y[i], term)
+		}
+	}
+
+	// Back substitution U·x = y
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			term.Mul(lu.M[i][j], y[j])
+			y[i].Sub(y[i], term)
+		}
+		y[i].Quo(y[i], lu.M[i][i])
+	}
+
+	x := make([]*BigFloat, n)
+	for i := range y {
+		x[i] = new(BigFloat).SetPrec(prec).Set(y[i])
+	}
+	return x, nil
+}
+
+// CholeskyBig computes the lower-triangular Cholesky factor L of a symmetric
+// positive-definite matrix, so that L·Lᵀ = a. Only the lower triangle of a
+// is read. Returns an error if a is not square or if a non-positive pivot
+// shows that a is not positive-definite.
+func CholeskyBig(a *BigMatrixN, prec uint) (*BigMatrixN, error) {
+	if a.Rows != a.Cols {
+		return nil, fmt.Errorf("cannot Cholesky-decompose %dx%d matrix: matrix must be square", a.Rows, a.Cols)
+	}
+	prec = a.precOrDefault(prec)
+	workPrec := prec + 32
+	n := a.Rows
+
+	l, _ := NewBigMatrixN(n, n, workPrec)
+	sum := new(BigFloat).SetPrec(workPrec)
+	term := new(BigFloat).SetPrec(workPrec)
+	for j := 0; j < n; j++ {
+		// L[j][j] = √(a[j][j] - Σₖ L[j][k]²)
+		sum.Set(a.M[j][j])
+		for k := 0; k < j; k++ {
+			term.Mul(l.M[j][k], l.M[j][k])
+			sum.Sub(sum, term)
+		}
+		if sum.Sign() <= 0 {
+			return nil, fmt.Errorf("matrix is not positive-definite: pivot %d is %s", j, sum.Text('g', 10))
+		}
+		l.M[j][j] = BigSqrt(sum, workPrec)
+
+		// L[i][j] = (a[i][j] - Σₖ L[i][k]·L[j][k]) / L[j][j]
+		for i := j + 1; i < n; i++ {
+			sum.Set(a.M[i][j])
+			for k := 0; k < j; k++ {
+				term.Mul(l.M[i][k], l.M[j][k])
+				sum.Sub(sum, term)
+			}
+			l.M[i][j].Quo(sum, l.M[j][j])
+		}
+	}
+
+	res, _ := NewBigMatrixN(n, n, prec)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			res.M[i][j].Set(l.M[i][j])
+		}
+	}
+	return res, nil
+}
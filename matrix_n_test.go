@@ -0,0 +1,373 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"strings"
+	"testing"
+)
+
+// testMatrixN builds an n×n matrix with distinct, non-trivial entries
+func testMatrixN(n int, seed float64, prec uint) *BigMatrixN {
+	m, _ := NewBigMatrixN(n, n, prec)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			m.M[i][j] = NewBigFloat(seed*float64(i+1)-float64(j*j)/7.0, prec)
+		}
+	}
+	return m
+}
+
+// matrixNEqual reports whether a and b agree element-wise within tol
+func matrixNEqual(a, b *BigMatrixN, tol *BigFloat) bool {
+	if a.Rows != b.Rows || a.Cols != b.Cols {
+		return false
+	}
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			diff := new(BigFloat).Sub(a.M[i][j], b.M[i][j])
+			if diff.Abs(diff).Cmp(tol) > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestNewBigMatrixN(t *testing.T) {
+	prec := uint(128)
+
+	m, err := NewBigMatrixN(2, 3, prec)
+	if err != nil {
+		t.Fatalf("NewBigMatrixN(2, 3) error: %v", err)
+	}
+	if m.Rows != 2 || m.Cols != 3 || len(m.M) != 2 || len(m.M[0]) != 3 {
+		t.Errorf("NewBigMatrixN(2, 3) has shape %dx%d", m.Rows, m.Cols)
+	}
+	if m.M[1][2].Sign() != 0 || m.M[1][2].Prec() != prec {
+		t.Errorf("NewBigMatrixN element = %s at prec %d, want 0 at prec %d", m.M[1][2].Text('g', 10), m.M[1][2].Prec(), prec)
+	}
+
+	for _, dims := range [][2]int{{0, 3}, {3, 0}, {-1, 2}} {
+		if _, err := NewBigMatrixN(dims[0], dims[1], prec); err == nil {
+			t.Errorf("NewBigMatrixN(%d, %d) expected error", dims[0], dims[1])
+		}
+	}
+
+	if _, err := NewBigMatrixNFromFloat64([][]float64{{1, 2}, {3}}, prec); err == nil {
+		t.Error("NewBigMatrixNFromFloat64 with ragged rows expected error")
+	}
+
+	id, err := NewIdentityMatrixN(4, prec)
+	if err != nil {
+		t.Fatalf("NewIdentityMatrixN(4) error: %v", err)
+	}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			want := 0.0
+			if i == j {
+				want = 1.0
+			}
+			if id.M[i][j].Cmp(NewBigFloat(want, prec)) != 0 {
+				t.Errorf("identity[%d][%d] = %s, want %g", i, j, id.M[i][j].Text('g', 10), want)
+			}
+		}
+	}
+}
+
+func TestBigMatrixNMul(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	a := testMatrixN(5, 1.5, prec)
+	b := testMatrixN(5, -0.25, prec)
+	c := testMatrixN(5, 3.0, prec)
+
+	t.Run("associativity", func(t *testing.T) {
+		ab, _ := a.Mul(b, prec)
+		left, _ := ab.Mul(c, prec)
+		bc, _ := b.Mul(c, prec)
+		right, _ := a.Mul(bc, prec)
+		if !matrixNEqual(left, right, tol) {
+			t.Error("(A·B)·C != A·(B·C) for 5x5 matrices")
+		}
+	})
+
+	t.Run("identity", func(t *testing.T) {
+		id, _ := NewIdentityMatrixN(5, prec)
+		ai, _ := a.Mul(id, prec)
+		ia, _ := id.Mul(a, prec)
+		if !matrixNEqual(ai, a, tol) || !matrixNEqual(ia, a, tol) {
+			t.Error("A·I and I·A must equal A for a 5x5 matrix")
+		}
+	})
+
+	t.Run("rectangular", func(t *testing.T) {
+		r, _ := NewBigMatrixNFromFloat64([][]float64{{1, 2, 3}, {4, 5, 6}}, prec)
+		s, _ := NewBigMatrixNFromFloat64([][]float64{{7, 8}, {9, 10}, {11, 12}}, prec)
+		want, _ := NewBigMatrixNFromFloat64([][]float64{{58, 64}, {139, 154}}, prec)
+		got, err := r.Mul(s, prec)
+		if err != nil {
+			t.Fatalf("Mul error: %v", err)
+		}
+		if !matrixNEqual(got, want, tol) {
+			t.Error("2x3 · 3x2 product is wrong")
+		}
+	})
+
+	t.Run("parallel_matches_serial", func(t *testing.T) {
+		// Row bands must give bit-identical results, including when the
+		// rows do not divide evenly between the workers
+		zero := new(BigFloat)
+		big1 := testMatrixN(64, 0.75, prec)
+		big2 := testMatrixN(64, -1.125, prec)
+		serial := big1.mul(big2, prec, 1)
+		for _, workers := range []int{2, 3, 8, 64, 100} {
+			if got := big1.mul(big2, prec, workers); !matrixNEqual(got, serial, zero) {
+				t.Errorf("64x64 product with %d workers differs from the serial product", workers)
+			}
+		}
+		if got, _ := big1.Mul(big2, prec); !matrixNEqual(got, serial, zero) {
+			t.Error("Mul of 64x64 matrices differs from the serial product")
+		}
+
+		tall, _ := NewBigMatrixN(67, 5, prec)
+		for i := range tall.M {
+			for j := range tall.M[i] {
+				tall.M[i][j] = NewBigFloat(float64(i*j)-0.5*float64(i), prec)
+			}
+		}
+		c5 := testMatrixN(5, 2.5, prec)
+		if got := tall.mul(c5, prec, 4); !matrixNEqual(got, tall.mul(c5, prec, 1), zero) {
+			t.Error("67x5 product with 4 workers differs from the serial product")
+		}
+	})
+
+	t.Run("dimension_mismatch", func(t *testing.T) {
+		r, _ := NewBigMatrixN(2, 3, prec)
+		_, err := r.Mul(r, prec)
+		if err == nil || !strings.Contains(err.Error(), "2x3") {
+			t.Errorf("Mul(2x3, 2x3) error = %v, want a dimension error", err)
+		}
+	})
+}
+
+func TestBigMatrixNAddSubTransposeScale(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	a := testMatrixN(5, 1.5, prec)
+	b := testMatrixN(5, -0.25, prec)
+
+	sum, err := a.Add(b, prec)
+	if err != nil {
+		t.Fatalf("Add error: %v", err)
+	}
+	back, _ := sum.Sub(b, prec)
+	if !matrixNEqual(back, a, tol) {
+		t.Error("(A + B) - B != A")
+	}
+
+	twice := a.Scale(NewBigFloat(2.0, prec), prec)
+	aa, _ := a.Add(a, prec)
+	if !matrixNEqual(twice, aa, tol) {
+		t.Error("2·A != A + A")
+	}
+
+	// (A·B)ᵀ = Bᵀ·Aᵀ
+	ab, _ := a.Mul(b, prec)
+	btat, _ := b.Transpose(prec).Mul(a.Transpose(prec), prec)
+	if !matrixNEqual(ab.Transpose(prec), btat, tol) {
+		t.Error("(A·B)ᵀ != Bᵀ·Aᵀ")
+	}
+
+	r, _ := NewBigMatrixN(2, 3, prec)
+	if rt := r.Transpose(0); rt.Rows != 3 || rt.Cols != 2 {
+		t.Errorf("Transpose of 2x3 has shape %dx%d, want 3x2", rt.Rows, rt.Cols)
+	}
+	if _, err := a.Add(r, prec); err == nil {
+		t.Error("Add with mismatched dimensions expected error")
+	}
+	if _, err := a.Sub(r, prec); err == nil {
+		t.Error("Sub with mismatched dimensions expected error")
+	}
+}
+
+// hilbertMatrixN returns the n×n Hilbert matrix H[i][j] = 1/(i+j+1)
+func hilbertMatrixN(n int, prec uint) *BigMatrixN {
+	h, _ := NewBigMatrixN(n, n, prec)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			h.M[i][j].Quo(NewBigFloat(1.0, prec), NewBigFloat(float64(i+j+1), prec))
+		}
+	}
+	return h
+}
+
+func TestSolveBig(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-30", prec)
+
+	t.Run("hilbert_4x4", func(t *testing.T) {
+		a := hilbertMatrixN(4, prec)
+		b := []*BigFloat{NewBigFloat(1.0, prec), NewBigFloat(-2.0, prec), NewBigFloat(3.0, prec), NewBigFloat(0.5, prec)}
+
+		x, err := SolveBig(a, b, prec)
+		if err != nil {
+			t.Fatalf("SolveBig error: %v", err)
+		}
+
+		// A·x must reproduce b
+		for i := 0; i < 4; i++ {
+			ax := new(BigFloat).SetPrec(prec)
+			for j := 0; j < 4; j++ {
+				ax.Add(ax, new(BigFloat).SetPrec(prec).Mul(a.M[i][j], x[j]))
+			}
+			diff := new(BigFloat).SetPrec(prec).Sub(ax, b[i])
+			if diff.Abs(diff).Cmp(tol) > 0 {
+				t.Errorf("(A·x)[%d] = %s, want %s", i, ax.Text('g', 40), b[i].Text('g', 40))
+			}
+		}
+	})
+
+	t.Run("requires_pivoting", func(t *testing.T) {
+		// The zero in the top-left corner forces a row exchange
+		a, _ := NewBigMatrixNFromFloat64([][]float64{{0, 1, 2}, {1, 0, 3}, {4, -3, 8}}, prec)
+		b := []*BigFloat{NewBigFloat(8.0, prec), NewBigFloat(10.0, prec), NewBigFloat(22.0, prec)}
+		x, err := SolveBig(a, b, prec)
+		if err != nil {
+			t.Fatalf("SolveBig error: %v", err)
+		}
+		for i, want := range []float64{1.0, 2.0, 3.0} {
+			diff := new(BigFloat).SetPrec(prec).Sub(x[i], NewBigFloat(want, prec))
+			if diff.Abs(diff).Cmp(tol) > 0 {
+				t.Errorf("x[%d] = %s, want %g", i, x[i].Text('g', 40), want)
+			}
+		}
+	})
+
+	t.Run("singular", func(t *testing.T) {
+		a, _ := NewBigMatrixNFromFloat64([][]float64{{1, 2, 3}, {2, 4, 6}, {1, 0, 1}}, prec)
+		b := []*BigFloat{NewBigFloat(1.0, prec), NewBigFloat(2.0, prec), NewBigFloat(3.0, prec)}
+		if _, err := SolveBig(a, b, prec); err == nil || !strings.Contains(err.Error(), "singular") {
+			t.Errorf("SolveBig on a singular matrix error = %v, want singular", err)
+		}
+	})
+
+	t.Run("dimension_errors", func(t *testing.T) {
+		rect, _ := NewBigMatrixN(2, 3, prec)
+		if _, err := SolveBig(rect, []*BigFloat{NewBigFloat(1.0, prec), NewBigFloat(1.0, prec)}, prec); err == nil {
+			t.Error("SolveBig on a non-square matrix expected error")
+		}
+		sq := hilbertMatrixN(3, prec)
+		if _, err := SolveBig(sq, []*BigFloat{NewBigFloat(1.0, prec)}, prec); err == nil {
+			t.Error("SolveBig with a short right-hand side expected error")
+		}
+	})
+}
+
+func TestLUDecompose(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	a, _ := NewBigMatrixNFromFloat64([][]float64{{0, 1, 2}, {1, 0, 3}, {4, -3, 8}}, prec)
+	lu, pivots, sign, err := LUDecompose(a, prec)
+	if err != nil {
+		t.Fatalf("LUDecompose error: %v", err)
+	}
+
+	// Rebuild P·A from L and U
+	n := a.Rows
+	l, _ := NewIdentityMatrixN(n, prec)
+	u, _ := NewBigMatrixN(n, n, prec)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if j < i {
+				l.M[i][j].Set(lu.M[i][j])
+			} else {
+				u.M[i][j].Set(lu.M[i][j])
+			}
+		}
+	}
+	product, _ := l.Mul(u, prec)
+	pa, _ := NewBigMatrixN(n, n, prec)
+	for i, p := range pivots {
+		for j := 0; j < n; j++ {
+			pa.M[i][j].Set(a.M[p][j])
+		}
+	}
+	if !matrixNEqual(product, pa, tol) {
+		t.Error("L·U != P·A")
+	}
+
+	// det(A) = sign·∏ U[i][i]; for this matrix det = -2
+	det := NewBigFloat(float64(sign), prec)
+	for i := 0; i < n; i++ {
+		det.Mul(det, lu.M[i][i])
+	}
+	diff := new(BigFloat).SetPrec(prec).Sub(det, NewBigFloat(-2.0, prec))
+	if diff.Abs(diff).Cmp(tol) > 0 {
+		t.Errorf("sign·∏U[i][i] = %s, want det(A) = -2", det.Text('g', 30))
+	}
+
+	zero, _ := NewBigMatrixN(3, 3, prec)
+	if _, _, _, err := LUDecompose(zero, prec); err == nil {
+		t.Error("LUDecompose of the zero matrix expected error")
+	}
+}
+
+func TestCholeskyBig(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-35", prec)
+
+	t.Run("reconstruct", func(t *testing.T) {
+		a, _ := NewBigMatrixNFromFloat64([][]float64{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}}, prec)
+		l, err := CholeskyBig(a, prec)
+		if err != nil {
+			t.Fatalf("CholeskyBig error: %v", err)
+		}
+
+		// Known factor [[2,0,0],[6,1,0],[-8,5,3]]
+		want, _ := NewBigMatrixNFromFloat64([][]float64{{2, 0, 0}, {6, 1, 0}, {-8, 5, 3}}, prec)
+		if !matrixNEqual(l, want, tol) {
+			t.Error("CholeskyBig factor differs from [[2,0,0],[6,1,0],[-8,5,3]]")
+		}
+
+		llt, _ := l.Mul(l.Transpose(prec), prec)
+		if !matrixNEqual(llt, a, tol) {
+			t.Error("L·Lᵀ != A")
+		}
+	})
+
+	t.Run("hilbert", func(t *testing.T) {
+		a := hilbertMatrixN(3, prec)
+		l, err := CholeskyBig(a, prec)
+		if err != nil {
+			t.Fatalf("CholeskyBig error: %v", err)
+		}
+		for i := 0; i < 3; i++ {
+			for j := i + 1; j < 3; j++ {
+				if l.M[i][j].Sign() != 0 {
+					t.Errorf("L[%d][%d] = %s, want 0 above the diagonal", i, j, l.M[i][j].Text('g', 10))
+				}
+			}
+		}
+		llt, _ := l.Mul(l.Transpose(prec), prec)
+		if !matrixNEqual(llt, a, tol) {
+			t.Error("L·Lᵀ != H for the 3x3 Hilbert matrix")
+		}
+	})
+
+	t.Run("not_positive_definite", func(t *testing.T) {
+		// Eigenvalues 3 and -1
+		a, _ := NewBigMatrixNFromFloat64([][]float64{{1, 2}, {2, 1}}, prec)
+		if _, err := CholeskyBig(a, prec); err == nil || !strings.Contains(err.Error(), "positive-definite") {
+			t.Errorf("CholeskyBig on an indefinite matrix error = %v, want not positive-definite", err)
+		}
+		rect, _ := NewBigMatrixN(2, 3, prec)
+		if _, err := CholeskyBig(rect, prec); err == nil {
+			t.Error("CholeskyBig on a non-square matrix expected error")
+		}
+	})
+}
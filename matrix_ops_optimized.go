@@ -39,8 +39,9 @@ func bigMatMulMatOptimized(m1, m2 *BigMatrix3x3, prec uint) *BigMatrix3x3 {
 
 	result := &BigMatrix3x3{M: [3][3]*BigFloat{}}
 
-	// Preallocate temporary BigFloats for computation
-	temp := new(BigFloat).SetPrec(prec)
+	// Temporary for the products, taken from the pool
+	temp := getScratch(prec)
+	defer putScratch(temp)
 
 	// Compute each element with unrolled multiplication
 	for i := 0; i < 3; i++ {
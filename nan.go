@@ -0,0 +1,121 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"math"
+	"math/big"
+)
+
+// BigFloatNaN is a BigFloat that can also represent NaN
+// big.Float has no NaN, so the plain API returns 0 for invalid results.
+// BigFloatNaN carries an explicit flag instead, and its arithmetic propagates
+// NaN and produces it for invalid operations such as ∞ - ∞ or 0/0.
+type BigFloatNaN struct {
+	Value *BigFloat
+	IsNaN bool
+}
+
+// NewBigFloatNaN wraps x as a non-NaN value
+// x is not copied.
+func NewBigFloatNaN(x *BigFloat) *BigFloatNaN {
+	return &BigFloatNaN{Value: x}
+}
+
+// NaN returns a NaN value with the given precision
+func NaN(prec uint) *BigFloatNaN {
+	return &BigFloatNaN{Value: NewBigFloat(0.0, prec), IsNaN: true}
+}
+
+// BigFloat returns the wrapped value and true, or the NaN sentinel (0) and
+// false if x is NaN
+func (x *BigFloatNaN) BigFloat() (*BigFloat, bool) {
+	if x.IsNaN {
+		return NewBigFloat(math.NaN(), x.Value.Prec()), false
+	}
+	return x.Value, true
+}
+
+// String formats x like big.Float's %g, or "NaN"
+func (x *BigFloatNaN) String() string {
+	if x.IsNaN {
+		return "NaN"
+	}
+	return x.Value.Text('g', 10)
+}
+
+// Add returns x + y, or NaN if either operand is NaN or the sum is ∞ - ∞
+func (x *BigFloatNaN) Add(y *BigFloatNaN, prec uint) *BigFloatNaN {
+	return nanBinaryOp(x, y, prec, (*BigFloat).Add)
+}
+
+// Sub returns x - y, or NaN if either operand is NaN or the difference is ∞ - ∞
+func (x *BigFloatNaN) Sub(y *BigFloatNaN, prec uint) *BigFloatNaN {
+	return nanBinaryOp(x, y, prec, (*BigFloat).Sub)
+}
+
+// Mul returns x * y, or NaN if either operand is NaN or the product is 0 * ∞
+func (x *BigFloatNaN) Mul(y *BigFloatNaN, prec uint) *BigFloatNaN {
+	return nanBinaryOp(x, y, prec, (*BigFloat).Mul)
+}
+
+// Quo returns x / y, or NaN if either operand is NaN or the quotient is 0/0 or ∞/∞
+func (x *BigFloatNaN) Quo(y *BigFloatNaN, prec uint) *BigFloatNaN {
+	return nanBinaryOp(x, y, prec, (*BigFloat).Quo)
+}
+
+// nanBinaryOp applies op, turning NaN operands and big.ErrNaN panics into NaN
+func nanBinaryOp(x, y *BigFloatNaN, prec uint, op func(z, x, y *BigFloat) *BigFloat) (result *BigFloatNaN) {
+	if prec == 0 {
+		prec = x.Value.Prec()
+	}
+	if x.IsNaN || y.IsNaN {
+		return NaN(prec)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(big.ErrNaN); !ok {
+				panic(r)
+			}
+			result = NaN(prec)
+		}
+	}()
+
+	return NewBigFloatNaN(op(new(BigFloat).SetPrec(prec), x.Value, y.Value))
+}
+
+// BigSqrtNaN computes √x, returning NaN for negative x
+func BigSqrtNaN(x *BigFloat, prec uint) *BigFloatNaN {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	if x.Sign() < 0 {
+		return NaN(prec)
+	}
+	return NewBigFloatNaN(BigSqrt(x, prec))
+}
+
+// BigAcoshNaN computes acosh(x), returning NaN for x < 1
+func BigAcoshNaN(x *BigFloat, prec uint) *BigFloatNaN {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	if x.Cmp(NewBigFloat(1.0, prec)) < 0 {
+		return NaN(prec)
+	}
+	return NewBigFloatNaN(BigAcosh(x, prec))
+}
+
+// BigLogbNaN computes the base-b logarithm of x, returning NaN if x <= 0,
+// base <= 0 or base = 1
+func BigLogbNaN(x, base *BigFloat, prec uint) *BigFloatNaN {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	if x.Sign() <= 0 || base.Sign() <= 0 || base.Cmp(NewBigFloat(1.0, prec)) == 0 {
+		return NaN(prec)
+	}
+	return NewBigFloatNaN(BigLogb(x, base, prec))
+}
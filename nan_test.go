@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"testing"
+)
+
+func TestBigFloatNaNPropagation(t *testing.T) {
+	prec := uint(256)
+	val := func(f float64) *BigFloatNaN { return NewBigFloatNaN(NewBigFloat(f, prec)) }
+
+	t.Run("chain", func(t *testing.T) {
+		// ((√-4 + 1) * 3) / 2 stays NaN through every step
+		r := BigSqrtNaN(NewBigFloat(-4.0, prec), prec)
+		r = r.Add(val(1), prec).Mul(val(3), prec).Quo(val(2), prec)
+		if !r.IsNaN {
+			t.Errorf("chain result = %s, want NaN", r)
+		}
+		if v, ok := r.BigFloat(); ok || v.Sign() != 0 {
+			t.Errorf("BigFloat() = %s, %v; want NaN sentinel and false", v.Text('g', 10), ok)
+		}
+	})
+
+	t.Run("valid_chain", func(t *testing.T) {
+		// (√9 + 1) * 3 / 2 = 6
+		r := BigSqrtNaN(NewBigFloat(9.0, prec), prec).Add(val(1), prec).Mul(val(3), prec).Quo(val(2), prec)
+		v, ok := r.BigFloat()
+		if !ok || !BigEqualFloat64(v, 6.0) {
+			t.Errorf("chain result = %s, want 6", r)
+		}
+	})
+
+	t.Run("invalid_operations", func(t *testing.T) {
+		inf := NewBigFloatNaN(new(BigFloat).SetPrec(prec).SetInf(false))
+		negInf := NewBigFloatNaN(new(BigFloat).SetPrec(prec).SetInf(true))
+		tests := map[string]*BigFloatNaN{
+			"inf_minus_inf":  inf.Add(negInf, prec),
+			"inf_sub_inf":    inf.Sub(inf, prec),
+			"zero_times_inf": val(0).Mul(inf, prec),
+			"zero_over_zero": val(0).Quo(val(0), prec),
+			"inf_over_inf":   inf.Quo(inf, prec),
+		}
+		for name, r := range tests {
+			if !r.IsNaN {
+				t.Errorf("%s = %s, want NaN", name, r)
+			}
+		}
+
+		// Division of a non-zero value by zero is ±Inf, not NaN
+		if r := val(1).Quo(val(0), prec); r.IsNaN || !r.Value.IsInf() {
+			t.Errorf("1/0 = %s, want +Inf", r)
+		}
+	})
+
+	t.Run("domain_errors", func(t *testing.T) {
+		if r := BigAcoshNaN(NewBigFloat(0.5, prec), prec); !r.IsNaN {
+			t.Errorf("BigAcoshNaN(0.5) = %s, want NaN", r)
+		}
+		if r := BigAcoshNaN(NewBigFloat(1.0, prec), prec); r.IsNaN || r.Value.Sign() != 0 {
+			t.Errorf("BigAcoshNaN(1) = %s, want 0", r)
+		}
+		if r := BigLogbNaN(NewBigFloat(8.0, prec), NewBigFloat(1.0, prec), prec); !r.IsNaN {
+			t.Errorf("BigLogbNaN(8, 1) = %s, want NaN", r)
+		}
+		if r := BigLogbNaN(NewBigFloat(-8.0, prec), NewBigFloat(2.0, prec), prec); !r.IsNaN {
+			t.Errorf("BigLogbNaN(-8, 2) = %s, want NaN", r)
+		}
+		r := BigLogbNaN(NewBigFloat(8.0, prec), NewBigFloat(2.0, prec), prec)
+		if v, ok := r.BigFloat(); !ok {
+			t.Error("BigLogbNaN(8, 2) is NaN")
+		} else if got, _ := v.Float64(); got < 2.9999999 || got > 3.0000001 {
+			t.Errorf("BigLogbNaN(8, 2) = %g, want 3", got)
+		}
+	})
+
+	if s := NaN(prec).String(); s != "NaN" {
+		t.Errorf("NaN.String() = %q, want \"NaN\"", s)
+	}
+}
@@ -5,6 +5,7 @@ package bigmath
 
 import (
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
 	"testing"
@@ -438,3 +439,207 @@ func TestNewBigFloatFromStringBoundaryPrecision(t *testing.T) {
 		})
 	}
 }
+
+// TestNewBigFloatFromBigIntRat tests exact construction from big.Int and big.Rat
+func TestNewBigFloatFromBigIntRat(t *testing.T) {
+	prec := uint(256)
+
+	t.Run("40_digit_int_exact", func(t *testing.T) {
+		i, _ := new(big.Int).SetString("1234567890123456789012345678901234567891", 10)
+		x := NewBigFloatFromBigInt(i, prec)
+		back, acc := x.Int(nil)
+		if back.Cmp(i) != 0 || acc != big.Exact {
+			t.Errorf("NewBigFloatFromBigInt = %s, want %s exactly", back, i)
+		}
+
+		// The float64 path cannot hold 40 significant digits
+		f, _ := new(big.Float).SetInt(i).Float64()
+		lossy, _ := NewBigFloat(f, prec).Int(nil)
+		if lossy.Cmp(i) == 0 {
+			t.Errorf("NewBigFloat(float64) unexpectedly represented %s exactly", i)
+		}
+	})
+
+	t.Run("int_rounded", func(t *testing.T) {
+		i := new(big.Int).Lsh(big.NewInt(1), 100)
+		i.Add(i, big.NewInt(1))
+		x := NewBigFloatFromBigInt(i, 53)
+		if x.Prec() != 53 {
+			t.Errorf("Prec = %d, want 53", x.Prec())
+		}
+		if x.Cmp(new(big.Float).SetMantExp(big.NewFloat(1), 100)) != 0 {
+			t.Errorf("2^100+1 at prec 53 = %s, want 2^100", x.Text('g', 40))
+		}
+	})
+
+	t.Run("rat", func(t *testing.T) {
+		third := NewBigFloatFromRat(big.NewRat(1, 3), prec)
+		want := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1, prec), NewBigFloat(3, prec))
+		if third.Cmp(want) != 0 {
+			t.Errorf("NewBigFloatFromRat(1/3) = %s, want %s", third.Text('g', 80), want.Text('g', 80))
+		}
+
+		exact := NewBigFloatFromRat(big.NewRat(-7, 8), prec)
+		if exact.Cmp(NewBigFloat(-0.875, prec)) != 0 {
+			t.Errorf("NewBigFloatFromRat(-7/8) = %s, want -0.875", exact.Text('g', 20))
+		}
+	})
+
+	t.Run("default_precision", func(t *testing.T) {
+		if x := NewBigFloatFromBigInt(big.NewInt(5), 0); x.Prec() != DefaultPrecision {
+			t.Errorf("NewBigFloatFromBigInt prec = %d, want %d", x.Prec(), DefaultPrecision)
+		}
+		if x := NewBigFloatFromRat(big.NewRat(5, 2), 0); x.Prec() != DefaultPrecision {
+			t.Errorf("NewBigFloatFromRat prec = %d, want %d", x.Prec(), DefaultPrecision)
+		}
+	})
+}
+
+// TestBigFloatToRat tests exact conversion to big.Rat and the round trip
+func TestBigFloatToRat(t *testing.T) {
+	prec := uint(256)
+	third := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1, prec), NewBigFloat(3, prec))
+	pi := BigPI(prec)
+
+	tests := []struct {
+		name string
+		x    *BigFloat
+	}{
+		{"zero", NewBigFloat(0, prec)},
+		{"integer", NewBigFloat(-42, prec)},
+		{"dyadic", NewBigFloat(0.375, prec)},
+		{"third", third},
+		{"pi", pi},
+		{"tiny", new(BigFloat).SetPrec(prec).SetMantExp(NewBigFloat(0.75, prec), -2000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, ok := BigFloatToRat(tt.x)
+			if !ok {
+				t.Fatalf("BigFloatToRat(%s) returned ok = false", tt.x.Text('g', 20))
+			}
+			back := NewBigFloatFromRat(r, prec)
+			if back.Cmp(tt.x) != 0 {
+				t.Errorf("round trip = %s, want %s", back.Text('g', 80), tt.x.Text('g', 80))
+			}
+		})
+	}
+
+	t.Run("dyadic_value", func(t *testing.T) {
+		r, _ := BigFloatToRat(NewBigFloat(0.375, prec))
+		if r.Cmp(big.NewRat(3, 8)) != 0 {
+			t.Errorf("BigFloatToRat(0.375) = %s, want 3/8", r)
+		}
+	})
+
+	t.Run("inf", func(t *testing.T) {
+		for _, sign := range []int{1, -1} {
+			if r, ok := BigFloatToRat(new(BigFloat).SetInf(sign < 0)); ok || r != nil {
+				t.Errorf("BigFloatToRat(%dInf) = (%v, %v), want (nil, false)", sign, r, ok)
+			}
+		}
+	})
+}
+
+// TestNewBigFloatFromFraction tests parsing of integer fraction strings
+func TestNewBigFloatFromFraction(t *testing.T) {
+	prec := uint(256)
+
+	t.Run("355/113", func(t *testing.T) {
+		x, err := NewBigFloatFromFraction("355/113", prec)
+		if err != nil {
+			t.Fatalf("NewBigFloatFromFraction failed: %v", err)
+		}
+		diff := new(BigFloat).SetPrec(prec).Sub(x, BigPI(prec))
+		tol := NewBigFloat(1e-6, prec)
+		if diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("355/113 = %s, want π to ~6 digits", x.Text('g', 20))
+		}
+	})
+
+	t.Run("1/3", func(t *testing.T) {
+		x, err := NewBigFloatFromFraction("1/3", prec)
+		if err != nil {
+			t.Fatalf("NewBigFloatFromFraction failed: %v", err)
+		}
+		want := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1, prec), NewBigFloat(3, prec))
+		if x.Cmp(want) != 0 {
+			t.Errorf("1/3 = %s, want %s", x.Text('g', 80), want.Text('g', 80))
+		}
+	})
+
+	t.Run("signed", func(t *testing.T) {
+		x, err := NewBigFloatFromFraction("-7/8", prec)
+		if err != nil || x.Cmp(NewBigFloat(-0.875, prec)) != 0 {
+			t.Errorf("-7/8 = (%v, %v), want -0.875", x, err)
+		}
+	})
+
+	invalid := []string{"1/2/3", "/5", "5/", "", "1.5/2", "a/b", "1/0", "355"}
+	for _, s := range invalid {
+		t.Run("invalid_"+s, func(t *testing.T) {
+			if x, err := NewBigFloatFromFraction(s, prec); err == nil {
+				t.Errorf("NewBigFloatFromFraction(%q) = %s, want error", s, x.Text('g', 20))
+			}
+		})
+	}
+}
+
+// TestNewBigFloatFromStringLocalized tests parsing with a grouping separator
+func TestNewBigFloatFromStringLocalized(t *testing.T) {
+	prec := uint(256)
+
+	valid := []struct {
+		name     string
+		input    string
+		sep      rune
+		expected string
+	}{
+		{"comma_grouping", "1,234,567.89", ',', "1234567.89"},
+		{"negative", "-12,345", ',', "-12345"},
+		{"exponent", "+1,000e-3", ',', "1"},
+		{"no_separator", "3.25", ',', "3.25"},
+		{"apostrophe", "9'876'543", '\'', "9876543"},
+		{"underscore", "1_000_000.5", '_', "1000000.5"},
+	}
+
+	for _, tt := range valid {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewBigFloatFromStringLocalized(tt.input, tt.sep, prec)
+			if err != nil {
+				t.Fatalf("NewBigFloatFromStringLocalized(%q) failed: %v", tt.input, err)
+			}
+			want, _ := NewBigFloatFromString(tt.expected, prec)
+			if got.Cmp(want) != 0 {
+				t.Errorf("NewBigFloatFromStringLocalized(%q) = %s, want %s", tt.input, got.Text('g', 30), tt.expected)
+			}
+		})
+	}
+
+	invalid := []struct {
+		input string
+		sep   rune
+	}{
+		{"1,2,3", ','},
+		{"12,34", ','},
+		{"1,5", ','},
+		{"1,2345", ','},
+		{",123", ','},
+		{"123,", ','},
+		{"1,,234", ','},
+		{"1,234.567,8", ','},
+		{"1.5e1,000", ','},
+		{"1,234", '.'},
+		{"1,234", '1'},
+		{"1 000", ' '},
+	}
+
+	for _, tt := range invalid {
+		t.Run("invalid_"+tt.input, func(t *testing.T) {
+			if got, err := NewBigFloatFromStringLocalized(tt.input, tt.sep, prec); err == nil {
+				t.Errorf("NewBigFloatFromStringLocalized(%q, %q) = %s, want error", tt.input, tt.sep, got.Text('g', 20))
+			}
+		})
+	}
+}
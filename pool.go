@@ -0,0 +1,31 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"math/big"
+	"sync"
+)
+
+// bigFloatPool recycles temporary BigFloat values so that hot loops reuse
+// mantissa buffers instead of allocating new ones on every call
+var bigFloatPool = sync.Pool{
+	New: func() any { return new(BigFloat) },
+}
+
+// getScratch returns a zero BigFloat with precision prec and rounding mode
+// ToNearestEven from the pool. The value must not escape the caller and
+// should be returned with putScratch when it is no longer needed.
+func getScratch(prec uint) *BigFloat {
+	x := bigFloatPool.Get().(*BigFloat)
+	x.SetMode(big.ToNearestEven)
+	return x.SetPrec(prec).SetInt64(0)
+}
+
+// putScratch returns values obtained from getScratch to the pool
+func putScratch(xs ...*BigFloat) {
+	for _, x := range xs {
+		bigFloatPool.Put(x)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestScratchPool(t *testing.T) {
+	// A value returned dirty must come back as a clean zero
+	x := getScratch(64)
+	x.SetMode(big.ToZero)
+	x.SetFloat64(-12.5)
+	putScratch(x)
+
+	for i := 0; i < 4; i++ {
+		y := getScratch(256)
+		if y.Sign() != 0 || y.Prec() != 256 || y.Mode() != big.ToNearestEven {
+			t.Errorf("getScratch(256) = %s (prec %d, mode %v), want 0 at prec 256 rounding ToNearestEven", y.Text('g', 10), y.Prec(), y.Mode())
+		}
+		putScratch(y)
+	}
+}
+
+func TestTrigGenericPooled(t *testing.T) {
+	// The pooled series loops must keep agreeing with the dispatched
+	// implementations, including when called repeatedly
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-70", prec)
+
+	for _, v := range []float64{-3, -0.75, 0, 1e-20, 0.5, 1.2345, 2.5, 100} {
+		x := NewBigFloat(v, prec)
+		for i := 0; i < 2; i++ {
+			if got, want := bigSinGeneric(x, prec), BigSin(x, prec); !BigFloatEqual(got, want, tol) {
+				t.Errorf("bigSinGeneric(%g) = %s, want %s", v, got.Text('g', 40), want.Text('g', 40))
+			}
+			if got, want := bigCosGeneric(x, prec), BigCos(x, prec); !BigFloatEqual(got, want, tol) {
+				t.Errorf("bigCosGeneric(%g) = %s, want %s", v, got.Text('g', 40), want.Text('g', 40))
+			}
+		}
+	}
+}
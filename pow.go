@@ -4,7 +4,10 @@
 package bigmath
 
 import (
+	"errors"
 	"math"
+	"math/big"
+	"math/bits"
 )
 
 // BigPow computes x^y with specified precision
@@ -14,6 +17,16 @@ func BigPow(x, y *BigFloat, prec uint) *BigFloat {
 	return getDispatcher().BigPowImpl(x, y, prec)
 }
 
+// BigPowInt computes x^n for an integer exponent by binary exponentiation
+// n = 0 returns 1 for every x, including 0^0. Negative n returns the
+// reciprocal of x^|n|, and negative bases give a negative result for odd n.
+func BigPowInt(x *BigFloat, n int64, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	return bigPowInteger(x, n, prec)
+}
+
 // bigPowGeneric is the generic implementation (called by dispatcher)
 // This is the actual implementation - do not call BigPow from here to avoid recursion
 func bigPowGeneric(x, y *BigFloat, prec uint) *BigFloat {
@@ -40,15 +53,10 @@ func bigPowGeneric(x, y *BigFloat, prec uint) *BigFloat {
 		return new(BigFloat).SetPrec(prec).Set(x)
 	}
 
-	// Check if y is integer
+	// Exact integer exponents use binary exponentiation, which is faster
+	// than exp(y*ln(x)) and exact whenever the result fits in prec bits
 	if y.IsInt() {
-		yInt, _ := y.Int64()
-		// Use integer power if y fits in int64
-		// But wait, IsInt() returns true for 1.0, 2.0 etc.
-		// If y is very large integer, we might still want exp/log
-		// but for small integers, repeated squaring is better.
-		// Let's use generic integer pow if small enough.
-		if yInt >= -1000000 && yInt <= 1000000 {
+		if yInt, acc := y.Int64(); acc == big.Exact {
 			return bigPowInteger(x, yInt, prec)
 		}
 	}
@@ -57,11 +65,12 @@ func bigPowGeneric(x, y *BigFloat, prec uint) *BigFloat {
 	if x.Sign() < 0 {
 		// If y is integer, we can compute.
 		if y.IsInt() {
-			yInt, _ := y.Int64()
+			// y is beyond int64 here, so take its parity from the exact integer
+			yInt, _ := y.Int(nil)
 			absX := new(BigFloat).SetPrec(prec).Abs(x)
 			// Use dispatcher directly to avoid recursion
 			res := getDispatcher().BigPowImpl(absX, y, prec)
-			if yInt%2 != 0 {
+			if yInt.Bit(0) != 0 {
 				res.Neg(res)
 			}
 			return res
@@ -91,29 +100,117 @@ func bigPowGeneric(x, y *BigFloat, prec uint) *BigFloat {
 	return new(BigFloat).SetPrec(prec).Set(res)
 }
 
-// bigPowInteger computes x^n for integer n
+// bigPowInteger computes x^n for integer n by binary exponentiation
+// Each squaring roughly doubles the relative error, so the loop carries
+// about log2(|n|) guard bits to keep the result accurate to prec.
 func bigPowInteger(x *BigFloat, n int64, prec uint) *BigFloat {
 	if n == 0 {
 		return NewBigFloat(1.0, prec)
 	}
 
-	if n < 0 {
-		// x^-n = 1/x^n
-		res := bigPowInteger(x, -n, prec)
-		return new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1.0, prec), res)
+	neg := n < 0
+	// Work with the magnitude as uint64 so that n = MinInt64 does not overflow
+	m := uint64(n)
+	if neg {
+		m = -m
 	}
 
-	// Binary exponentiation
-	res := NewBigFloat(1.0, prec)
-	base := new(BigFloat).SetPrec(prec).Set(x)
+	workPrec := prec + uint(bits.Len64(m)) + 16
+	res := NewBigFloat(1.0, workPrec)
+	base := new(BigFloat).SetPrec(workPrec).Set(x)
 
-	for n > 0 {
-		if n%2 == 1 {
+	for m > 0 {
+		if m&1 == 1 {
 			res.Mul(res, base)
 		}
-		base.Mul(base, base)
-		n /= 2
+		m >>= 1
+		if m > 0 {
+			base.Mul(base, base)
+		}
+	}
+
+	if neg {
+		// x^-n = 1/x^n
+		res.Quo(NewBigFloat(1.0, workPrec), res)
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(res)
+}
+
+// BigTetration computes the power tower base^(base^(...^base)) with height copies of base
+// Height 0 returns 1 by convention and height 1 returns base.
+// If the tower exceeds the exponent range of BigFloat, +Inf is returned.
+// Returns an error for negative height or a negative base, whose towers raise
+// a negative number to non-integer powers.
+func BigTetration(base *BigFloat, height int, prec uint) (*BigFloat, error) {
+	if height < 0 {
+		return nil, errors.New("tetration height must be non-negative")
+	}
+	if base.Sign() < 0 {
+		return nil, errors.New("tetration base must be non-negative")
+	}
+	if prec == 0 {
+		prec = base.Prec()
+	}
+
+	if height == 0 {
+		return NewBigFloat(1.0, prec), nil
+	}
+
+	// Each level multiplies the relative error of the exponent by roughly
+	// t*ln(base), so carry extra guard bits through the tower
+	workPrec := prec + 64
+
+	// log2(|base|), used to estimate the binary exponent of each level
+	var log2Base *BigFloat
+	if base.Sign() != 0 && !base.IsInf() {
+		absBase := new(BigFloat).SetPrec(64).Abs(base)
+		log2Base = new(BigFloat).SetPrec(64).Quo(BigLog(absBase, 64), BigLn2(64))
+	}
+	maxExp := NewBigFloat(float64(big.MaxExp), 64)
+
+	result := new(BigFloat).SetPrec(workPrec).Set(base)
+	for i := 1; i < height; i++ {
+		// base^t has a binary exponent of about t*log2(base)
+		if log2Base != nil && log2Base.Sign() > 0 && result.Sign() > 0 {
+			est := new(BigFloat).SetPrec(64).Mul(result, log2Base)
+			if est.Cmp(maxExp) > 0 {
+				return new(BigFloat).SetPrec(prec).SetInf(false), nil
+			}
+		}
+		result = BigPow(base, result, workPrec)
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(result), nil
+}
+
+// BigPowModInt computes base^exp mod |mod| exactly for integer-valued inputs
+// The computation is delegated to big.Int, so the result is exact regardless
+// of the size of the intermediate power. A negative exp uses the modular
+// inverse of base, which must exist. Returns an error if any argument is not
+// an integer or if mod is zero.
+func BigPowModInt(base, exp, mod *BigFloat) (*BigFloat, error) {
+	if !BigIsInteger(base) || !BigIsInteger(exp) || !BigIsInteger(mod) {
+		return nil, errors.New("modular exponentiation requires integer arguments")
+	}
+	if mod.Sign() == 0 {
+		return nil, errors.New("modulus must be non-zero")
+	}
+
+	b, _ := base.Int(nil)
+	e, _ := exp.Int(nil)
+	m, _ := mod.Int(nil)
+
+	res := new(big.Int).Exp(b, e, m)
+	if res == nil {
+		return nil, errors.New("base has no inverse modulo mod")
+	}
+
+	// Keep enough bits to hold the result exactly
+	prec := mod.Prec()
+	if bits := uint(res.BitLen()); bits > prec {
+		prec = bits
 	}
 
-	return res
+	return new(BigFloat).SetPrec(prec).SetInt(res), nil
 }
@@ -5,6 +5,7 @@ package bigmath
 
 import (
 	"math"
+	"math/big"
 	"testing"
 )
 
@@ -225,11 +226,10 @@ func TestBigPowLargeExponents(t *testing.T) {
 		x := NewBigFloat(2.0, prec)
 		y := NewBigFloat(30.0, prec)
 		result := BigPow(x, y, prec)
-		resultFloat, _ := result.Float64()
 
-		expected := math.Pow(2.0, 30.0) // 1073741824
-		if math.Abs(resultFloat-expected) > 1e-3 {
-			t.Errorf("2^30 = %v, want %v", resultFloat, expected)
+		// Integer exponents take the exact binary exponentiation path
+		if result.Cmp(NewBigFloat(1073741824, prec)) != 0 {
+			t.Errorf("2^30 = %s, want 1073741824 exactly", result.Text('g', 30))
 		}
 	})
 
@@ -237,11 +237,90 @@ func TestBigPowLargeExponents(t *testing.T) {
 		x := NewBigFloat(10.0, prec)
 		y := NewBigFloat(10.0, prec)
 		result := BigPow(x, y, prec)
-		resultFloat, _ := result.Float64()
 
-		expected := 1e10
-		if math.Abs(resultFloat-expected) > 1e-3 {
-			t.Errorf("10^10 = %v, want %v", resultFloat, expected)
+		if result.Cmp(NewBigFloat(1e10, prec)) != 0 {
+			t.Errorf("10^10 = %s, want 1e10 exactly", result.Text('g', 30))
+		}
+	})
+
+	t.Run("3^150_exact", func(t *testing.T) {
+		// 3^150 needs 238 bits, so it is exactly representable at 256 bits
+		want := new(big.Int).Exp(big.NewInt(3), big.NewInt(150), nil)
+		result := BigPow(NewBigFloat(3.0, prec), NewBigFloat(150.0, prec), prec)
+		if result.Cmp(new(BigFloat).SetInt(want)) != 0 {
+			t.Errorf("3^150 = %s, want %s", result.Text('f', 0), want)
+		}
+	})
+
+	t.Run("negative_base_and_exponent", func(t *testing.T) {
+		if result := BigPow(NewBigFloat(-2.0, prec), NewBigFloat(61.0, prec), prec); result.Cmp(NewBigFloat(-math.Pow(2, 61), prec)) != 0 {
+			t.Errorf("(-2)^61 = %s, want -2^61", result.Text('g', 30))
+		}
+
+		// 1.25^-200 = 4^200/5^200 agrees with the exact quotient to within an ulp
+		result := BigPow(NewBigFloat(1.25, prec), NewBigFloat(-200.0, prec), prec)
+		num := new(big.Int).Exp(big.NewInt(4), big.NewInt(200), nil)
+		den := new(big.Int).Exp(big.NewInt(5), big.NewInt(200), nil)
+		want := new(BigFloat).SetPrec(prec).SetRat(new(big.Rat).SetFrac(num, den))
+		diff := new(BigFloat).SetPrec(prec).Sub(result, want)
+		if diff.Sign() != 0 && diff.MantExp(nil) > want.MantExp(nil)-int(prec) {
+			t.Errorf("1.25^-200 = %s, want %s", result.Text('g', 80), want.Text('g', 80))
+		}
+	})
+}
+
+func TestBigPowInt(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-45", prec)
+	third := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1.0, prec), NewBigFloat(27.0, prec))
+
+	tests := []struct {
+		name     string
+		x        *BigFloat
+		n        int64
+		expected *BigFloat
+	}{
+		{"negative_base_odd", NewBigFloat(-2.0, prec), 3, NewBigFloat(-8.0, prec)},
+		{"negative_base_even", NewBigFloat(-2.0, prec), 4, NewBigFloat(16.0, prec)},
+		{"zero_exponent", NewBigFloat(7.0, prec), 0, NewBigFloat(1.0, prec)},
+		{"zero_to_zero", NewBigFloat(0.0, prec), 0, NewBigFloat(1.0, prec)},
+		{"negative_exponent", NewBigFloat(3.0, prec), -3, third},
+		{"one_exponent", NewBigFloat(1.5, prec), 1, NewBigFloat(1.5, prec)},
+		{"zero_negative_exponent", NewBigFloat(0.0, prec), -2, new(BigFloat).SetInf(false)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := BigPowInt(tt.x, tt.n, prec)
+			if result.Prec() != prec {
+				t.Errorf("BigPowInt precision = %d, want %d", result.Prec(), prec)
+			}
+			if tt.expected.IsInf() {
+				if result.Cmp(tt.expected) != 0 {
+					t.Errorf("BigPowInt(%s, %d) = %s, want %s", tt.x.Text('g', 10), tt.n, result.Text('g', 10), tt.expected.Text('g', 10))
+				}
+				return
+			}
+			diff := new(BigFloat).SetPrec(prec).Sub(result, tt.expected)
+			if diff.Abs(diff).Cmp(tol) > 0 {
+				t.Errorf("BigPowInt(%s, %d) = %s, want %s", tt.x.Text('g', 10), tt.n, result.Text('g', 50), tt.expected.Text('g', 50))
+			}
+		})
+	}
+
+	t.Run("matches_BigPow", func(t *testing.T) {
+		x, _ := NewBigFloatFromString("1.0001", prec)
+		for _, n := range []int64{2, 17, 1000, -45} {
+			if got, want := BigPowInt(x, n, prec), BigPow(x, NewBigFloat(float64(n), prec), prec); got.Cmp(want) != 0 {
+				t.Errorf("BigPowInt(1.0001, %d) = %s, BigPow = %s", n, got.Text('g', 50), want.Text('g', 50))
+			}
+		}
+	})
+
+	t.Run("min_int64", func(t *testing.T) {
+		// Negating MinInt64 must not overflow
+		if result := BigPowInt(NewBigFloat(1.0, prec), math.MinInt64, prec); result.Cmp(NewBigFloat(1.0, prec)) != 0 {
+			t.Errorf("BigPowInt(1, MinInt64) = %s, want 1", result.Text('g', 10))
 		}
 	})
 }
@@ -332,6 +411,30 @@ func TestBigPowIdentities(t *testing.T) {
 	})
 }
 
+// TestBigSqrt tests BigSqrt beyond 256 bits and for tiny arguments
+func TestBigSqrt(t *testing.T) {
+	for _, tc := range []struct {
+		x    string
+		prec uint
+	}{
+		{"2", 1024},
+		{"1e-200", 256},
+		{"3e-300", 512},
+	} {
+		x, _ := NewBigFloatFromString(tc.x, tc.prec)
+		got := BigSqrt(x, tc.prec)
+
+		// got² must equal x to within about one ulp
+		sq := new(BigFloat).SetPrec(2*tc.prec).Mul(got, got)
+		diff := new(BigFloat).SetPrec(tc.prec).Sub(sq, x)
+		diff.Quo(diff, x)
+		tol := new(BigFloat).SetMantExp(NewBigFloat(1.0, tc.prec), 2-int(tc.prec))
+		if diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("BigSqrt(%s, %d)² has relative error %s", tc.x, tc.prec, diff.Text('g', 5))
+		}
+	}
+}
+
 // TestBigSqrtRounded tests BigSqrtRounded (defined in bigmath.go)
 func TestBigSqrtRounded(t *testing.T) {
 	prec := uint(256)
@@ -352,3 +455,139 @@ func TestBigSqrtRounded(t *testing.T) {
 		}
 	})
 }
+
+// TestBigTetration tests power towers of small height
+func TestBigTetration(t *testing.T) {
+	prec := uint(256)
+	two := NewBigFloat(2.0, prec)
+
+	tests := []struct {
+		name     string
+		base     *BigFloat
+		height   int
+		expected float64
+	}{
+		{"height_zero", two, 0, 1.0},
+		{"height_zero_any_base", NewBigFloat(123.456, prec), 0, 1.0},
+		{"height_one", NewBigFloat(3.5, prec), 1, 3.5},
+		{"two_height_two", two, 2, 4.0},
+		{"two_height_three", two, 3, 16.0},
+		{"two_height_four", two, 4, 65536.0},
+		{"three_height_two", NewBigFloat(3.0, prec), 2, 27.0},
+		{"one", NewBigFloat(1.0, prec), 7, 1.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := BigTetration(tt.base, tt.height, prec)
+			if err != nil {
+				t.Fatalf("BigTetration error: %v", err)
+			}
+			if result.Cmp(NewBigFloat(tt.expected, prec)) != 0 {
+				t.Errorf("BigTetration = %s, want %g", result.Text('g', 30), tt.expected)
+			}
+		})
+	}
+
+	t.Run("two_height_five", func(t *testing.T) {
+		// 2^65536 is far beyond float64 but within BigFloat's exponent range
+		result, err := BigTetration(two, 5, prec)
+		if err != nil {
+			t.Fatalf("BigTetration error: %v", err)
+		}
+		want := new(BigFloat).SetPrec(prec).SetMantExp(NewBigFloat(1.0, prec), 65536)
+		if result.Cmp(want) != 0 {
+			t.Errorf("BigTetration(2, 5) = %s, want 2^65536", result.Text('g', 10))
+		}
+	})
+
+	t.Run("sqrt2_converges_to_two", func(t *testing.T) {
+		result, err := BigTetration(BigSqrt2(prec), 10, prec)
+		if err != nil {
+			t.Fatalf("BigTetration error: %v", err)
+		}
+		got, _ := result.Float64()
+		// The √2 tower increases monotonically toward 2
+		if got <= 1.98 || got >= 2.0 {
+			t.Errorf("BigTetration(√2, 10) = %g, want in (1.98, 2)", got)
+		}
+
+		more, _ := BigTetration(BigSqrt2(prec), 40, prec)
+		gotMore, _ := more.Float64()
+		if math.Abs(gotMore-2.0) >= math.Abs(got-2.0) {
+			t.Errorf("BigTetration(√2, 40) = %g is not closer to 2 than height 10", gotMore)
+		}
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		result, err := BigTetration(two, 6, prec)
+		if err != nil {
+			t.Fatalf("BigTetration error: %v", err)
+		}
+		if !result.IsInf() || result.Sign() < 0 {
+			t.Errorf("BigTetration(2, 6) = %s, want +Inf", result.Text('g', 10))
+		}
+	})
+
+	t.Run("negative_height", func(t *testing.T) {
+		if _, err := BigTetration(two, -1, prec); err == nil {
+			t.Error("BigTetration with negative height should return an error")
+		}
+	})
+
+	t.Run("negative_base", func(t *testing.T) {
+		for _, height := range []int{0, 1, 3} {
+			if _, err := BigTetration(NewBigFloat(-2, prec), height, prec); err == nil {
+				t.Errorf("BigTetration(-2, %d) should return an error", height)
+			}
+		}
+	})
+}
+
+func TestBigPowModInt(t *testing.T) {
+	prec := uint(256)
+	n := func(f float64) *BigFloat { return NewBigFloat(f, prec) }
+
+	tests := []struct {
+		name           string
+		base, exp, mod float64
+		want           float64
+	}{
+		{"2^10_mod_1000", 2, 10, 1000, 24},
+		{"3^5_mod_7", 3, 5, 7, 5},
+		{"exp_zero", 5, 0, 13, 1},
+		{"negative_exp_inverse", 3, -1, 7, 5},
+		{"large_exp", 2, 1e15, 1e9 + 7, 264444359},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := BigPowModInt(n(tt.base), n(tt.exp), n(tt.mod))
+			if err != nil {
+				t.Fatalf("BigPowModInt error: %v", err)
+			}
+			if result.Cmp(n(tt.want)) != 0 {
+				t.Errorf("BigPowModInt(%g, %g, %g) = %s, want %g", tt.base, tt.exp, tt.mod, result.Text('g', 20), tt.want)
+			}
+		})
+	}
+
+	errTests := []struct {
+		name           string
+		base, exp, mod float64
+	}{
+		{"non_integer_base", 2.5, 3, 7},
+		{"non_integer_exp", 2, 2.5, 7},
+		{"non_integer_mod", 2, 3, 7.5},
+		{"zero_modulus", 2, 3, 0},
+		{"no_inverse", 2, -1, 4},
+	}
+
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := BigPowModInt(n(tt.base), n(tt.exp), n(tt.mod)); err == nil {
+				t.Errorf("BigPowModInt(%g, %g, %g) should return an error", tt.base, tt.exp, tt.mod)
+			}
+		})
+	}
+}
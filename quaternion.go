@@ -0,0 +1,163 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+// BigQuaternion represents the quaternion W + Xi + Yj + Zk
+// Unit quaternions represent rotations in 3D.
+type BigQuaternion struct {
+	W, X, Y, Z *BigFloat
+}
+
+// NewBigQuaternion creates a new BigQuaternion from float64 components
+func NewBigQuaternion(w, x, y, z float64, prec uint) *BigQuaternion {
+	return &BigQuaternion{
+		W: NewBigFloat(w, prec),
+		X: NewBigFloat(x, prec),
+		Y: NewBigFloat(y, prec),
+		Z: NewBigFloat(z, prec),
+	}
+}
+
+// signedProductSum returns Σ signs[i]·a[i]·b[i] accumulated with 32 guard
+// bits and rounded once to prec
+func signedProductSum(signs [4]int, a, b [4]*BigFloat, prec uint) *BigFloat {
+	workPrec := prec + 32
+	sum := new(BigFloat).SetPrec(workPrec)
+	p := new(BigFloat).SetPrec(workPrec)
+	for i := range signs {
+		p.Mul(a[i], b[i])
+		if signs[i] < 0 {
+			sum.Sub(sum, p)
+		} else {
+			sum.Add(sum, p)
+		}
+	}
+	return new(BigFloat).SetPrec(prec).Set(sum)
+}
+
+// Mul returns the Hamilton product q * r
+// The product is not commutative; as rotations, q * r applies r first.
+func (q *BigQuaternion) Mul(r *BigQuaternion, prec uint) *BigQuaternion {
+	if prec == 0 {
+		prec = q.W.Prec()
+	}
+
+	qc := [4]*BigFloat{q.W, q.X, q.Y, q.Z}
+	return &BigQuaternion{
+		W: signedProductSum([4]int{1, -1, -1, -1}, qc, [4]*BigFloat{r.W, r.X, r.Y, r.Z}, prec),
+		X: signedProductSum([4]int{1, 1, 1, -1}, qc, [4]*BigFloat{r.X, r.W, r.Z, r.Y}, prec),
+		Y: signedProductSum([4]int{1, -1, 1, 1}, qc, [4]*BigFloat{r.Y, r.Z, r.W, r.X}, prec),
+		Z: signedProductSum([4]int{1, 1, -1, 1}, qc, [4]*BigFloat{r.Z, r.Y, r.X, r.W}, prec),
+	}
+}
+
+// Conjugate returns W - Xi - Yj - Zk
+// For a unit quaternion the conjugate is its inverse, the opposite rotation.
+func (q *BigQuaternion) Conjugate(prec uint) *BigQuaternion {
+	if prec == 0 {
+		prec = q.W.Prec()
+	}
+
+	return &BigQuaternion{
+		W: new(BigFloat).SetPrec(prec).Set(q.W),
+		X: new(BigFloat).SetPrec(prec).Neg(q.X),
+		Y: new(BigFloat).SetPrec(prec).Neg(q.Y),
+		Z: new(BigFloat).SetPrec(prec).Neg(q.Z),
+	}
+}
+
+// Norm returns |q| = √(W² + X² + Y² + Z²)
+func (q *BigQuaternion) Norm(prec uint) *BigFloat {
+	if prec == 0 {
+		prec = q.W.Prec()
+	}
+
+	c := [4]*BigFloat{q.W, q.X, q.Y, q.Z}
+	normSq := signedProductSum([4]int{1, 1, 1, 1}, c, c, prec+32)
+	return BigSqrt(normSq, prec)
+}
+
+// Normalize returns q scaled to unit length
+// The zero quaternion is returned unchanged (as a copy).
+func (q *BigQuaternion) Normalize(prec uint) *BigQuaternion {
+	if prec == 0 {
+		prec = q.W.Prec()
+	}
+
+	workPrec := prec + 32
+	norm := q.Norm(workPrec)
+	if norm.Sign() == 0 {
+		return &BigQuaternion{
+			W: new(BigFloat).SetPrec(prec).Set(q.W),
+			X: new(BigFloat).SetPrec(prec).Set(q.X),
+			Y: new(BigFloat).SetPrec(prec).Set(q.Y),
+			Z: new(BigFloat).SetPrec(prec).Set(q.Z),
+		}
+	}
+
+	quo := func(x *BigFloat) *BigFloat {
+		return new(BigFloat).SetPrec(prec).Set(new(BigFloat).SetPrec(workPrec).Quo(x, norm))
+	}
+	return &BigQuaternion{W: quo(q.W), X: quo(q.X), Y: quo(q.Y), Z: quo(q.Z)}
+}
+
+// ToMatrix3x3 returns the rotation matrix of q
+// q does not have to be normalized: the matrix is built with the factor
+// s = 2/|q|², so q and any non-zero multiple of q give the same rotation.
+// The zero quaternion returns the identity.
+func (q *BigQuaternion) ToMatrix3x3(prec uint) *BigMatrix3x3 {
+	if prec == 0 {
+		prec = q.W.Prec()
+	}
+	workPrec := prec + 32
+
+	c := [4]*BigFloat{q.W, q.X, q.Y, q.Z}
+	normSq := signedProductSum([4]int{1, 1, 1, 1}, c, c, workPrec)
+	if normSq.Sign() == 0 {
+		return NewIdentityMatrix(prec)
+	}
+	s := new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(2.0, workPrec), normSq)
+
+	mul := func(a, b *BigFloat) *BigFloat { return new(BigFloat).SetPrec(workPrec).Mul(a, b) }
+	xx, yy, zz := mul(q.X, q.X), mul(q.Y, q.Y), mul(q.Z, q.Z)
+	xy, xz, yz := mul(q.X, q.Y), mul(q.X, q.Z), mul(q.Y, q.Z)
+	wx, wy, wz := mul(q.W, q.X), mul(q.W, q.Y), mul(q.W, q.Z)
+
+	// Diagonal entries are 1 - s(a + b), off-diagonal entries s(a ± b)
+	diag := func(a, b *BigFloat) *BigFloat {
+		d := new(BigFloat).SetPrec(workPrec).Add(a, b)
+		d.Mul(d, s)
+		d.Sub(NewBigFloat(1.0, workPrec), d)
+		return new(BigFloat).SetPrec(prec).Set(d)
+	}
+	off := func(a, b *BigFloat, add bool) *BigFloat {
+		d := new(BigFloat).SetPrec(workPrec)
+		if add {
+			d.Add(a, b)
+		} else {
+			d.Sub(a, b)
+		}
+		d.Mul(d, s)
+		return new(BigFloat).SetPrec(prec).Set(d)
+	}
+
+	return &BigMatrix3x3{
+		M: [3][3]*BigFloat{
+			{diag(yy, zz), off(xy, wz, false), off(xz, wy, true)},
+			{off(xy, wz, true), diag(xx, zz), off(yz, wx, false)},
+			{off(xz, wy, false), off(yz, wx, true), diag(xx, yy)},
+		},
+	}
+}
+
+// RotateVec3 rotates v by the rotation that q represents
+// The result equals q·v·q⁻¹ with v taken as a pure quaternion.
+func (q *BigQuaternion) RotateVec3(v *BigVec3, prec uint) *BigVec3 {
+	if prec == 0 {
+		prec = v.X.Prec()
+	}
+
+	workPrec := prec + 32
+	return roundBigVec3(BigMatMul(q.ToMatrix3x3(workPrec), v, workPrec), prec)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"testing"
+)
+
+func TestBigQuaternionMul(t *testing.T) {
+	prec := uint(128)
+	one := NewBigQuaternion(1, 0, 0, 0, prec)
+	i := NewBigQuaternion(0, 1, 0, 0, prec)
+	j := NewBigQuaternion(0, 0, 1, 0, prec)
+	k := NewBigQuaternion(0, 0, 0, 1, prec)
+
+	tests := []struct {
+		name     string
+		a, b     *BigQuaternion
+		expected [4]float64
+	}{
+		{"i*j=k", i, j, [4]float64{0, 0, 0, 1}},
+		{"j*i=-k", j, i, [4]float64{0, 0, 0, -1}},
+		{"j*k=i", j, k, [4]float64{0, 1, 0, 0}},
+		{"k*i=j", k, i, [4]float64{0, 0, 1, 0}},
+		{"i*i=-1", i, i, [4]float64{-1, 0, 0, 0}},
+		{"identity", one, NewBigQuaternion(1, 2, 3, 4, prec), [4]float64{1, 2, 3, 4}},
+		{"general", NewBigQuaternion(1, 2, 3, 4, prec), NewBigQuaternion(5, 6, 7, 8, prec), [4]float64{-60, 12, 30, 24}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.a.Mul(tt.b, prec)
+			for n, c := range []*BigFloat{p.W, p.X, p.Y, p.Z} {
+				if c.Cmp(NewBigFloat(tt.expected[n], prec)) != 0 {
+					t.Errorf("component %d = %s, want %g", n, c.Text('g', 20), tt.expected[n])
+				}
+			}
+		})
+	}
+}
+
+func TestBigQuaternionNormalizeConjugate(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	q := NewBigQuaternion(1, 2, 3, 4, prec)
+	u := q.Normalize(prec)
+	diff := new(BigFloat).SetPrec(prec).Sub(u.Norm(prec), NewBigFloat(1.0, prec))
+	if diff.Abs(diff).Cmp(tol) > 0 {
+		t.Errorf("|Normalize(q)| = %s, want 1", u.Norm(prec).Text('g', 40))
+	}
+
+	// q·q* = |q|² with no vector part
+	p := q.Mul(q.Conjugate(prec), prec)
+	if p.W.Cmp(NewBigFloat(30.0, prec)) != 0 || p.X.Sign() != 0 || p.Y.Sign() != 0 || p.Z.Sign() != 0 {
+		t.Errorf("q·q* = (%s, %s, %s, %s), want (30, 0, 0, 0)", p.W.Text('g', 10), p.X.Text('g', 10), p.Y.Text('g', 10), p.Z.Text('g', 10))
+	}
+
+	zero := NewBigQuaternion(0, 0, 0, 0, prec).Normalize(prec)
+	if zero.W.Sign() != 0 || zero.X.Sign() != 0 || zero.Y.Sign() != 0 || zero.Z.Sign() != 0 {
+		t.Error("Normalize of the zero quaternion must return zero")
+	}
+}
+
+func TestBigQuaternionRotation(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+
+	q := NewBigQuaternion(0.5, -1.25, 2, 0.75, prec).Normalize(prec)
+
+	t.Run("matrix_orthogonal", func(t *testing.T) {
+		m := q.ToMatrix3x3(prec)
+		if !BigMatIsOrthogonal(m, tol, prec) {
+			t.Error("ToMatrix3x3 of a unit quaternion is not orthogonal")
+		}
+		det := BigMatDet(m, prec)
+		diff := new(BigFloat).SetPrec(prec).Sub(det, NewBigFloat(1.0, prec))
+		if diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("det(ToMatrix3x3) = %s, want 1", det.Text('g', 40))
+		}
+	})
+
+	t.Run("inverse_round_trip", func(t *testing.T) {
+		v := NewBigVec3(1.5, -2.0, 3.25, prec)
+		back := q.Conjugate(prec).RotateVec3(q.RotateVec3(v, prec), prec)
+		if !BigVec3Equal(back, v, tol) {
+			t.Errorf("q⁻¹(q(v)) = %v, want %v", back.ToFloat64(), v.ToFloat64())
+		}
+	})
+
+	t.Run("quarter_turn_about_z", func(t *testing.T) {
+		half := BigHalfPI(prec)
+		half.Quo(half, NewBigFloat(2.0, prec))
+		s, c := BigSinCos(half, prec)
+		qz := &BigQuaternion{W: c, X: NewBigFloat(0, prec), Y: NewBigFloat(0, prec), Z: s}
+
+		got := qz.RotateVec3(NewBigVec3(1, 0, 0, prec), prec)
+		if !BigVec3Equal(got, NewBigVec3(0, 1, 0, prec), tol) {
+			t.Errorf("90° about z of (1,0,0) = %v, want (0,1,0)", got.ToFloat64())
+		}
+	})
+
+	t.Run("composition", func(t *testing.T) {
+		r := NewBigQuaternion(-0.3, 0.1, 0.4, -2, prec)
+		v := NewBigVec3(0.25, 7, -1, prec)
+		composed := q.Mul(r, prec).RotateVec3(v, prec)
+		sequential := q.RotateVec3(r.RotateVec3(v, prec), prec)
+		if !BigVec3Equal(composed, sequential, tol) {
+			t.Errorf("(q·r)(v) = %v, q(r(v)) = %v", composed.ToFloat64(), sequential.ToFloat64())
+		}
+	})
+}
@@ -7,17 +7,39 @@ import (
 	"math"
 )
 
-// BigCbrt computes the cube root of x using Newton-Raphson method
-// Returns NaN for negative inputs (or could be extended to handle negative)
+// BigCbrt computes the real cube root of x using Newton-Raphson method
+// Negative inputs give the negative real root (BigCbrt(-27) = -3), and ±Inf
+// gives ±Inf.
 func BigCbrt(x *BigFloat, prec uint) *BigFloat {
 	return getDispatcher().BigCbrtImpl(x, prec)
 }
 
+// cbrtSeed returns a float64-accurate starting guess for the cube root of a > 0
+// The exponent is split off first so that values outside the float64 range
+// still get a finite seed.
+func cbrtSeed(a *BigFloat, prec uint) *BigFloat {
+	mant := new(BigFloat)
+	exp := a.MantExp(mant)
+
+	// a = m * 2^exp with exp a multiple of 3, so cbrt(a) = cbrt(m) * 2^(exp/3)
+	shift := ((exp % 3) + 3) % 3
+	exp -= shift
+	m, _ := mant.Float64()
+	m = math.Ldexp(m, shift)
+
+	seed := NewBigFloat(math.Cbrt(m), prec)
+	return seed.SetMantExp(seed, exp/3)
+}
+
+// cbrtConverged reports whether the Newton step is within a few ulps of x at
+// precision prec; rounding can keep the iteration bouncing at that level
+func cbrtConverged(step, x *BigFloat, prec uint) bool {
+	return step.Sign() == 0 || step.MantExp(nil) <= x.MantExp(nil)-int(prec)+4
+}
+
 // bigCbrtPositive computes cube root for positive numbers
 func bigCbrtPositive(x *BigFloat, prec uint) *BigFloat {
-	// Initial guess using float64 cbrt
-	xFloat, _ := x.Float64()
-	guess := NewBigFloat(math.Cbrt(xFloat), prec)
+	guess := cbrtSeed(x, prec)
 
 	// Newton-Raphson: x_{n+1} = (2*x_n + a/(x_n^2)) / 3
 	// For cube root: f(x) = x^3 - a, f'(x) = 3*x^2
@@ -28,7 +50,6 @@ func bigCbrtPositive(x *BigFloat, prec uint) *BigFloat {
 	temp := new(BigFloat).SetPrec(prec)
 	temp2 := new(BigFloat).SetPrec(prec)
 	diff := new(BigFloat).SetPrec(prec)
-	threshold := new(BigFloat).SetPrec(prec).SetFloat64(1e-77) // Convergence threshold
 
 	for i := 0; i < 100; i++ { // Max 100 iterations
 		// Compute guess squared
@@ -43,13 +64,12 @@ func bigCbrtPositive(x *BigFloat, prec uint) *BigFloat {
 		// Update guess using Newton-Raphson formula: (2*guess + x/(guess^2)) / 3
 		temp.Quo(temp, three)
 
-		// Check convergence: |guess_new - guess|
+		// Check convergence: |guess_new - guess| relative to guess
 		diff.Sub(temp, guess)
-		diff = BigAbs(diff, prec)
 
 		guess.Set(temp)
 
-		if diff.Cmp(threshold) < 0 {
+		if cbrtConverged(diff, guess, prec) {
 			break
 		}
 	}
@@ -19,15 +19,20 @@ func bigCbrtGeneric(x *BigFloat, prec uint) *BigFloat {
 	if x.Sign() == 0 {
 		return NewBigFloat(0.0, prec)
 	}
+	if x.IsInf() {
+		return new(BigFloat).SetPrec(prec).SetInf(x.Sign() < 0)
+	}
+
+	workPrec := prec + 32
 	if x.Sign() < 0 {
 		// For negative numbers, compute cube root of absolute value and negate
-		absX := BigAbs(x, prec)
-		result := bigCbrtPositive(absX, prec)
+		absX := new(BigFloat).SetPrec(workPrec).Neg(x)
+		result := bigCbrtPositive(absX, workPrec)
 		result.Neg(result)
-		return result
+		return new(BigFloat).SetPrec(prec).Set(result)
 	}
 
-	return bigCbrtPositive(x, prec)
+	return new(BigFloat).SetPrec(prec).Set(bigCbrtPositive(x, workPrec))
 }
 
 // bigRootGeneric computes the nth root using pure Go implementation
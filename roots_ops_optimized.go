@@ -5,8 +5,6 @@
 
 package bigmath
 
-import "math"
-
 // Optimized root functions with reduced allocations
 
 // bigCbrtOptimized implements optimized cube root
@@ -49,14 +47,8 @@ func bigCbrtOptimized(x *BigFloat, prec uint) *BigFloat {
 // bigCbrtPositiveOptimized implements optimized Newton-Raphson for positive values
 // Formula: x_new = (2*x + a/x²)/3
 func bigCbrtPositiveOptimized(a *BigFloat, prec uint) *BigFloat {
-	// Get initial estimate
-	aFloat, _ := a.Float64()
-	x := NewBigFloat(1.0, prec)
-	if aFloat > 0 && !a.IsInf() {
-		// Better initial guess reduces iterations
-		guess := math.Cbrt(aFloat)
-		x.SetFloat64(guess)
-	}
+	// Get initial estimate; a good guess reduces iterations
+	x := cbrtSeed(a, prec)
 
 	// Preallocate temporaries to reduce allocations in loop
 	temp1 := new(BigFloat).SetPrec(prec)
@@ -65,11 +57,6 @@ func bigCbrtPositiveOptimized(a *BigFloat, prec uint) *BigFloat {
 	two := NewBigFloat(2.0, prec)
 	three := NewBigFloat(3.0, prec)
 
-	threshold := new(BigFloat).SetPrec(prec).SetMantExp(
-		NewBigFloat(1.0, prec),
-		-int(prec+10),
-	)
-
 	// Newton-Raphson with optimized allocation
 	maxIter := 100
 	for i := 0; i < maxIter; i++ {
@@ -86,10 +73,9 @@ func bigCbrtPositiveOptimized(a *BigFloat, prec uint) *BigFloat {
 		// Compute x_new = temp2 / 3
 		xNew := new(BigFloat).SetPrec(prec).Quo(temp2, three)
 
-		// Check convergence
+		// Check convergence relative to the iterate
 		temp1.Sub(xNew, x)
-		temp1.Abs(temp1)
-		if temp1.Cmp(threshold) < 0 {
+		if cbrtConverged(temp1, xNew, prec) {
 			return xNew
 		}
 		x = xNew
@@ -78,6 +78,63 @@ func TestBigCbrt(t *testing.T) {
 	})
 }
 
+func TestBigCbrtHighPrecision(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+
+	t.Run("negative_arguments", func(t *testing.T) {
+		for _, tc := range []struct{ x, want float64 }{{-27, -3}, {-8, -2}, {-0.001, -0.1}, {-1e-30, -1e-10}} {
+			got := BigCbrt(NewBigFloat(tc.x, prec), prec)
+			want := NewBigFloat(tc.want, prec)
+			relErr := new(BigFloat).SetPrec(prec).Sub(got, want)
+			relErr.Quo(relErr, want).Abs(relErr)
+			// 0.001 and 1e-30 are not exact in binary, so allow float64-level error there
+			limit := tol
+			if tc.x != -27 && tc.x != -8 {
+				limit = NewBigFloat(1e-15, prec)
+			}
+			if got.Sign() >= 0 || relErr.Cmp(limit) > 0 {
+				t.Errorf("BigCbrt(%g) = %s, want %g", tc.x, got.Text('g', 50), tc.want)
+			}
+		}
+	})
+
+	t.Run("special_values", func(t *testing.T) {
+		if got := BigCbrt(NewBigFloat(0.0, prec), prec); got.Sign() != 0 {
+			t.Errorf("BigCbrt(0) = %s, want 0", got.Text('g', 10))
+		}
+		if got := BigCbrt(new(BigFloat).SetPrec(prec).SetInf(false), prec); !got.IsInf() || got.Sign() < 0 {
+			t.Errorf("BigCbrt(+Inf) = %s, want +Inf", got.Text('g', 10))
+		}
+		if got := BigCbrt(new(BigFloat).SetPrec(prec).SetInf(true), prec); !got.IsInf() || got.Sign() > 0 {
+			t.Errorf("BigCbrt(-Inf) = %s, want -Inf", got.Text('g', 10))
+		}
+	})
+
+	// Property: cbrt(x)^3 = x to 1e-40, including values outside the float64 range
+	inputs := []string{"2", "-2", "10", "3.14159265358979323846264338327950288", "-1e-300", "7.5e400", "-1.23e-500", "1e100000"}
+	for _, p := range []uint{256, 512} {
+		for _, s := range inputs {
+			t.Run("cube_"+s, func(t *testing.T) {
+				x, err := NewBigFloatFromString(s, p)
+				if err != nil {
+					t.Fatalf("NewBigFloatFromString(%q) failed: %v", s, err)
+				}
+				for name, cbrt := range map[string]func(*BigFloat, uint) *BigFloat{"BigCbrt": BigCbrt, "generic": bigCbrtGeneric} {
+					r := cbrt(x, p)
+					cube := new(BigFloat).SetPrec(p).Mul(r, r)
+					cube.Mul(cube, r)
+					relErr := new(BigFloat).SetPrec(p).Sub(cube, x)
+					relErr.Quo(relErr, x).Abs(relErr)
+					if relErr.Cmp(tol) > 0 {
+						t.Errorf("%s prec %d: cbrt(%s)^3 has relative error %s", name, p, s, relErr.Text('g', 5))
+					}
+				}
+			})
+		}
+	}
+}
+
 func TestBigRoot(t *testing.T) {
 	prec := uint(256)
 
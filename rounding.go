@@ -80,6 +80,137 @@ func Round(x *BigFloat, prec uint, mode RoundingMode) (result *BigFloat, ternary
 	}
 }
 
+// RoundToBits rounds x to the given number of correct significant bits
+// using the specified rounding mode. The result is a copy of x whose
+// precision is exactly bits, so callers that only need a partial result
+// can trim the working precision of intermediate values.
+// Returns the rounded value and a ternary value with the same meaning as Round:
+//
+//	-1 if rounded down
+//	 0 if exact
+//	+1 if rounded up
+func RoundToBits(x *BigFloat, bits uint, mode RoundingMode) (result *BigFloat, ternary int) {
+	if bits == 0 {
+		bits = x.Prec()
+	}
+
+	// Set rounds x to the receiver's precision using the receiver's mode
+	result = new(BigFloat).SetMode(mode).SetPrec(bits)
+	result.Set(x)
+
+	switch result.Acc() {
+	case big.Below:
+		return result, -1
+	case big.Above:
+		return result, 1
+	default:
+		return result, 0
+	}
+}
+
+// BigRoundToInt rounds x to an integer using mode, which has the same meaning
+// as in Round:
+//
+//	ToNearest      nearest integer, halves to even (2.5 → 2, 3.5 → 4)
+//	ToNearestAway  nearest integer, halves away from zero (2.5 → 3, -2.5 → -3)
+//	ToZero, AwayFromZero, ToPositiveInf, ToNegativeInf  directed rounding
+//
+// The integer is determined exactly and then stored at prec bits (0 means
+// x.Prec()), so it is exact whenever it fits. The sign of x is kept for zero
+// results. Infinities are returned unchanged.
+func BigRoundToInt(x *BigFloat, mode RoundingMode, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	if x.IsInt() || x.IsInf() {
+		return new(BigFloat).SetPrec(prec).Set(x)
+	}
+
+	// i = trunc(x); frac = |x - i| is exact at x's precision
+	i, _ := x.Int(nil)
+	frac := new(BigFloat).SetPrec(x.Prec()).Sub(x, new(BigFloat).SetInt(i))
+	frac.Abs(frac)
+	if roundsAwayFromZero(mode, x.Sign(), frac.Cmp(big.NewFloat(0.5)), i.Bit(0) == 1) {
+		i.Add(i, big.NewInt(int64(x.Sign())))
+	}
+
+	result := new(BigFloat).SetPrec(prec).SetInt(i)
+	if i.Sign() == 0 && x.Signbit() {
+		result.Neg(result)
+	}
+	return result
+}
+
+// roundsAwayFromZero reports whether a non-integer value of the given sign,
+// truncated towards zero, must be moved one unit away from zero to round it
+// with mode. half compares the discarded fraction with 1/2 and odd is the
+// parity of the truncated value.
+func roundsAwayFromZero(mode RoundingMode, sign, half int, odd bool) bool {
+	switch mode {
+	case ToNearest:
+		return half > 0 || (half == 0 && odd)
+	case ToNearestAway:
+		return half >= 0
+	case AwayFromZero:
+		return true
+	case ToPositiveInf:
+		return sign > 0
+	case ToNegativeInf:
+		return sign < 0
+	}
+	return false
+}
+
+// BigRoundToDecimalPlaces rounds x to places digits after the decimal point
+// (before it for negative places) using mode as in BigRoundToInt. The scaling
+// by 10^places and the rounding are done exactly in rational arithmetic, so
+// decimal ties such as 0.125 → 0.12 are recognized and nothing is rounded
+// twice. The result is then rounded once to prec bits (0 means x.Prec()).
+func BigRoundToDecimalPlaces(x *BigFloat, places int, mode RoundingMode, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	if x.IsInf() || x.Sign() == 0 {
+		return new(BigFloat).SetPrec(prec).Set(x)
+	}
+
+	// x·10^places = num/den exactly
+	r, _ := x.Rat(nil)
+	num, den := new(big.Int).Set(r.Num()), new(big.Int).Set(r.Denom())
+	digits := int64(places)
+	if digits < 0 {
+		digits = -digits
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(digits), nil)
+	if places >= 0 {
+		num.Mul(num, scale)
+	} else {
+		den.Mul(den, scale)
+	}
+
+	// q = trunc(num/den), compare the remainder |rem|/den with 1/2
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() != 0 {
+		rem.Abs(rem).Lsh(rem, 1)
+		if roundsAwayFromZero(mode, x.Sign(), rem.Cmp(den), q.Bit(0) == 1) {
+			q.Add(q, big.NewInt(int64(x.Sign())))
+		}
+	}
+
+	// Scale back: q/10^places
+	var scaled *big.Rat
+	if places >= 0 {
+		scaled = new(big.Rat).SetFrac(q, scale)
+	} else {
+		scaled = new(big.Rat).SetInt(q.Mul(q, scale))
+	}
+	result := new(BigFloat).SetPrec(prec).SetRat(scaled)
+	if result.Sign() == 0 && x.Signbit() {
+		result.Neg(result)
+	}
+	return result
+}
+
 // SqrtRounded computes sqrt(x) and rounds according to mode
 func SqrtRounded(x *BigFloat, prec uint, mode RoundingMode) (result *BigFloat, ternary int) {
 	if prec == 0 {
@@ -121,3 +252,30 @@ func QuoRounded(a, b *BigFloat, prec uint, mode RoundingMode) (result *BigFloat,
 	// Round result
 	return Round(quo, prec, mode)
 }
+
+// RoundTo returns a copy of v with every component rounded to prec bits using
+// the specified rounding mode, together with the per-component ternary values
+// in X, Y, Z order (see Round for their meaning)
+func (v *BigVec3) RoundTo(prec uint, mode RoundingMode) (*BigVec3, [3]int) {
+	var ternary [3]int
+	result := &BigVec3{}
+	result.X, ternary[0] = Round(v.X, prec, mode)
+	result.Y, ternary[1] = Round(v.Y, prec, mode)
+	result.Z, ternary[2] = Round(v.Z, prec, mode)
+	return result, ternary
+}
+
+// RoundTo returns a copy of v with every component rounded to prec bits using
+// the specified rounding mode, together with the per-component ternary values
+// in X, Y, Z, VX, VY, VZ order (see Round for their meaning)
+func (v *BigVec6) RoundTo(prec uint, mode RoundingMode) (*BigVec6, [6]int) {
+	var ternary [6]int
+	result := &BigVec6{}
+	result.X, ternary[0] = Round(v.X, prec, mode)
+	result.Y, ternary[1] = Round(v.Y, prec, mode)
+	result.Z, ternary[2] = Round(v.Z, prec, mode)
+	result.VX, ternary[3] = Round(v.VX, prec, mode)
+	result.VY, ternary[4] = Round(v.VY, prec, mode)
+	result.VZ, ternary[5] = Round(v.VZ, prec, mode)
+	return result, ternary
+}
@@ -4,6 +4,7 @@
 package bigmath
 
 import (
+	"fmt"
 	"math"
 	"testing"
 )
@@ -156,6 +157,65 @@ func TestSqrtRounded(t *testing.T) {
 	})
 }
 
+// TestExpLogRounded checks BigExpRounded and BigLogRounded against a
+// reference computed at twice the precision and rounded with the same mode
+func TestExpLogRounded(t *testing.T) {
+	prec := uint(128)
+	modes := []RoundingMode{ToNearest, ToNearestAway, ToZero, ToPositiveInf, ToNegativeInf, AwayFromZero}
+	values := []float64{0.5, 1, 2.75, -3.125, 10, 1e-8}
+
+	check := func(t *testing.T, name string, x *BigFloat, rounded func(*BigFloat, uint, RoundingMode) (*BigFloat, int), exact func(*BigFloat, uint) *BigFloat) {
+		for _, mode := range modes {
+			got, ternary := rounded(x, prec, mode)
+			want, wantTernary := Round(exact(x, 2*prec), prec, mode)
+			if got.Prec() != prec {
+				t.Errorf("%s(%s, %v) prec = %d, want %d", name, x.Text('g', 10), mode, got.Prec(), prec)
+			}
+			if got.Cmp(want) != 0 {
+				t.Errorf("%s(%s, %v) = %s, want %s", name, x.Text('g', 10), mode, got.Text('g', 45), want.Text('g', 45))
+			}
+			if ternary != wantTernary {
+				t.Errorf("%s(%s, %v) ternary = %d, want %d", name, x.Text('g', 10), mode, ternary, wantTernary)
+			}
+		}
+	}
+
+	for _, v := range values {
+		x := NewBigFloat(v, 2*prec)
+		t.Run(fmt.Sprintf("exp_%g", v), func(t *testing.T) {
+			check(t, "BigExpRounded", x, BigExpRounded, BigExp)
+		})
+		if v > 0 {
+			t.Run(fmt.Sprintf("log_%g", v), func(t *testing.T) {
+				check(t, "BigLogRounded", x, BigLogRounded, BigLog)
+			})
+		}
+	}
+
+	t.Run("exact", func(t *testing.T) {
+		if r, ternary := BigExpRounded(NewBigFloat(0, prec), prec, ToZero); r.Cmp(NewBigFloat(1, prec)) != 0 || ternary != 0 {
+			t.Errorf("BigExpRounded(0) = (%s, %d), want (1, 0)", r.Text('g', 20), ternary)
+		}
+		if r, ternary := BigLogRounded(NewBigFloat(1, prec), prec, ToZero); r.Sign() != 0 || ternary != 0 {
+			t.Errorf("BigLogRounded(1) = (%s, %d), want (0, 0)", r.Text('g', 20), ternary)
+		}
+	})
+
+	t.Run("prec_zero", func(t *testing.T) {
+		x := NewBigFloat(2.75, 256)
+		for name, rounded := range map[string]func(*BigFloat, uint, RoundingMode) (*BigFloat, int){
+			"BigExpRounded": BigExpRounded,
+			"BigLogRounded": BigLogRounded,
+		} {
+			got, _ := rounded(x, 0, ToNearest)
+			want, _ := rounded(x, 256, ToNearest)
+			if got.Prec() != 256 || got.Cmp(want) != 0 {
+				t.Errorf("%s(x, 0) = %s at %d bits, want the 256-bit result", name, got.Text('g', 20), got.Prec())
+			}
+		}
+	})
+}
+
 // TestAddRounded tests AddRounded function
 func TestAddRounded(t *testing.T) {
 	tests := []struct {
@@ -306,3 +366,277 @@ func TestRoundingTernary(t *testing.T) {
 		_ = ternary
 	})
 }
+
+// TestRoundToBits tests RoundToBits precision, accuracy and rounding directions
+func TestRoundToBits(t *testing.T) {
+	// 1/3 is not representable in binary at any precision
+	third := new(BigFloat).SetPrec(256).Quo(NewBigFloat(1.0, 256), NewBigFloat(3.0, 256))
+	negThird := new(BigFloat).SetPrec(256).Neg(third)
+
+	t.Run("reduces_precision", func(t *testing.T) {
+		for _, bits := range []uint{8, 24, 53, 100, 200} {
+			result, _ := RoundToBits(third, bits, ToNearest)
+			if result.Prec() != bits {
+				t.Errorf("RoundToBits(1/3, %d) precision = %d, want %d", bits, result.Prec(), bits)
+			}
+
+			// |result - x| must be within 1 ULP of the rounded result
+			diff := new(BigFloat).SetPrec(256).Sub(result, third)
+			diff.Abs(diff)
+			if diff.Cmp(Ulp(result, bits)) > 0 {
+				t.Errorf("RoundToBits(1/3, %d) error %s exceeds 1 ULP", bits, diff.Text('g', 10))
+			}
+		}
+	})
+
+	t.Run("zero_bits_keeps_precision", func(t *testing.T) {
+		result, ternary := RoundToBits(third, 0, ToNearest)
+		if result.Prec() != third.Prec() {
+			t.Errorf("RoundToBits(x, 0) precision = %d, want %d", result.Prec(), third.Prec())
+		}
+		if ternary != 0 || result.Cmp(third) != 0 {
+			t.Errorf("RoundToBits(x, 0) should be exact, got ternary %d", ternary)
+		}
+	})
+
+	t.Run("exact_value", func(t *testing.T) {
+		result, ternary := RoundToBits(NewBigFloat(0.75, 256), 4, ToZero)
+		if ternary != 0 {
+			t.Errorf("RoundToBits(0.75, 4) ternary = %d, want 0", ternary)
+		}
+		if f, _ := result.Float64(); f != 0.75 {
+			t.Errorf("RoundToBits(0.75, 4) = %g, want 0.75", f)
+		}
+	})
+
+	modes := []struct {
+		name     string
+		mode     RoundingMode
+		posTern  int
+		negTern  int
+		checkOrd bool
+	}{
+		{"ToZero", ToZero, -1, 1, true},
+		{"AwayFromZero", AwayFromZero, 1, -1, true},
+		{"ToPositiveInf", ToPositiveInf, 1, 1, true},
+		{"ToNegativeInf", ToNegativeInf, -1, -1, true},
+		{"ToNearest", ToNearest, 0, 0, false},
+		{"ToNearestAway", ToNearestAway, 0, 0, false},
+	}
+
+	for _, m := range modes {
+		t.Run(m.name, func(t *testing.T) {
+			for _, tc := range []struct {
+				x    *BigFloat
+				want int
+			}{{third, m.posTern}, {negThird, m.negTern}} {
+				result, ternary := RoundToBits(tc.x, 53, m.mode)
+				if ternary == 0 {
+					t.Fatalf("RoundToBits(%s) ternary = 0 for non-representable value", tc.x.Text('g', 10))
+				}
+				if m.checkOrd && ternary != tc.want {
+					t.Errorf("RoundToBits(%s) ternary = %d, want %d", tc.x.Text('g', 10), ternary, tc.want)
+				}
+				// Ternary must agree with the actual direction of rounding
+				if result.Cmp(tc.x) != ternary {
+					t.Errorf("RoundToBits(%s) ternary = %d, but Cmp(result, x) = %d", tc.x.Text('g', 10), ternary, result.Cmp(tc.x))
+				}
+			}
+		})
+	}
+}
+
+// TestBigVecRoundTo tests component-wise rounding of BigVec3 and BigVec6
+func TestBigVecRoundTo(t *testing.T) {
+	srcPrec := uint(512)
+	third := new(BigFloat).SetPrec(srcPrec).Quo(NewBigFloat(1.0, srcPrec), NewBigFloat(3.0, srcPrec))
+	negSeventh := new(BigFloat).SetPrec(srcPrec).Quo(NewBigFloat(-1.0, srcPrec), NewBigFloat(7.0, srcPrec))
+
+	v := &BigVec6{
+		X:  third,
+		Y:  negSeventh,
+		Z:  NewBigFloat(0.5, srcPrec), // exactly representable
+		VX: BigPI(srcPrec),
+		VY: new(BigFloat).SetPrec(srcPrec).Neg(BigPI(srcPrec)),
+		VZ: NewBigFloat(-3.0, srcPrec), // exactly representable
+	}
+	components := func(r *BigVec6) [6]*BigFloat {
+		return [6]*BigFloat{r.X, r.Y, r.Z, r.VX, r.VY, r.VZ}
+	}
+
+	modes := []struct {
+		name string
+		mode RoundingMode
+		// wantSign is the sign every inexact ternary must have, 0 if it depends on the value
+		wantSign int
+	}{
+		{"ToPositiveInf", ToPositiveInf, 1},
+		{"ToNegativeInf", ToNegativeInf, -1},
+		{"ToZero", ToZero, 0},
+		{"ToNearest", ToNearest, 0},
+	}
+
+	for _, m := range modes {
+		t.Run(m.name, func(t *testing.T) {
+			r, ternary := v.RoundTo(64, m.mode)
+			src := components(v)
+			for i, c := range components(r) {
+				if c.Prec() != 64 {
+					t.Errorf("component %d precision = %d, want 64", i, c.Prec())
+				}
+				// The ternary must describe the actual rounding direction
+				if c.Cmp(src[i]) != ternary[i] {
+					t.Errorf("component %d ternary = %d, but Cmp(rounded, original) = %d", i, ternary[i], c.Cmp(src[i]))
+				}
+				if m.wantSign > 0 && ternary[i] < 0 || m.wantSign < 0 && ternary[i] > 0 {
+					t.Errorf("component %d ternary = %d under %s", i, ternary[i], m.name)
+				}
+			}
+
+			// Representable components are exact
+			if ternary[2] != 0 || ternary[5] != 0 {
+				t.Errorf("exact components reported ternary %d and %d, want 0", ternary[2], ternary[5])
+			}
+		})
+	}
+
+	t.Run("BigVec3", func(t *testing.T) {
+		v3 := &BigVec3{X: third, Y: NewBigFloat(2.0, srcPrec), Z: negSeventh}
+		r, ternary := v3.RoundTo(53, ToZero)
+		if r.X.Prec() != 53 || r.Y.Prec() != 53 || r.Z.Prec() != 53 {
+			t.Errorf("BigVec3.RoundTo precisions = (%d, %d, %d), want 53", r.X.Prec(), r.Y.Prec(), r.Z.Prec())
+		}
+		// Toward zero: positive values round down, negative values round up
+		if ternary != [3]int{-1, 0, 1} {
+			t.Errorf("BigVec3.RoundTo ternary = %v, want [-1 0 1]", ternary)
+		}
+		if v3.X.Prec() != srcPrec {
+			t.Error("RoundTo modified the source vector")
+		}
+	})
+}
+
+func TestBigRoundToInt(t *testing.T) {
+	prec := uint(256)
+
+	// Expected results in the order ToNearest, ToNearestAway, ToZero,
+	// AwayFromZero, ToPositiveInf, ToNegativeInf
+	modes := []RoundingMode{ToNearest, ToNearestAway, ToZero, AwayFromZero, ToPositiveInf, ToNegativeInf}
+	tests := []struct {
+		x    string
+		want [6]string
+	}{
+		{"2.5", [6]string{"2", "3", "2", "3", "3", "2"}},
+		{"-2.5", [6]string{"-2", "-3", "-2", "-3", "-2", "-3"}},
+		{"3.5", [6]string{"4", "4", "3", "4", "4", "3"}},
+		{"2.1", [6]string{"2", "2", "2", "3", "3", "2"}},
+		{"-2.7", [6]string{"-3", "-3", "-2", "-3", "-2", "-3"}},
+		{"0.5", [6]string{"0", "1", "0", "1", "1", "0"}},
+		{"7", [6]string{"7", "7", "7", "7", "7", "7"}},
+		{"1e30", [6]string{"1e30", "1e30", "1e30", "1e30", "1e30", "1e30"}},
+		{"123456789012345678901234567890.5", [6]string{
+			"123456789012345678901234567890", "123456789012345678901234567891",
+			"123456789012345678901234567890", "123456789012345678901234567891",
+			"123456789012345678901234567891", "123456789012345678901234567890",
+		}},
+	}
+
+	for _, tt := range tests {
+		x, _ := NewBigFloatFromString(tt.x, prec)
+		for m, mode := range modes {
+			t.Run(fmt.Sprintf("%s/%v", tt.x, mode), func(t *testing.T) {
+				want, _ := NewBigFloatFromString(tt.want[m], prec)
+				got := BigRoundToInt(x, mode, prec)
+				if got.Cmp(want) != 0 || !got.IsInt() {
+					t.Errorf("BigRoundToInt(%s, %v) = %s, want %s", tt.x, mode, got.Text('f', 2), tt.want[m])
+				}
+			})
+		}
+	}
+
+	t.Run("signed_zero", func(t *testing.T) {
+		got := BigRoundToInt(NewBigFloat(-0.25, prec), ToNearest, prec)
+		if got.Sign() != 0 || !got.Signbit() {
+			t.Errorf("BigRoundToInt(-0.25) = %s, want -0", got.Text('g', 10))
+		}
+	})
+
+	t.Run("infinity", func(t *testing.T) {
+		if got := BigRoundToInt(new(BigFloat).SetInf(true), ToNearest, prec); !got.IsInf() || got.Sign() > 0 {
+			t.Errorf("BigRoundToInt(-Inf) = %s, want -Inf", got.Text('g', 10))
+		}
+	})
+
+	t.Run("math_round_agreement", func(t *testing.T) {
+		// ToNearestAway matches math.Round and ToNearest matches math.RoundToEven
+		for _, v := range []float64{-3.5, -2.5, -1.5, -0.5, 0.5, 1.5, 2.5, 2.4999, 1e15 + 0.5} {
+			x := NewBigFloat(v, prec)
+			if got, _ := BigRoundToInt(x, ToNearestAway, prec).Float64(); got != math.Round(v) {
+				t.Errorf("BigRoundToInt(%g, ToNearestAway) = %g, want %g", v, got, math.Round(v))
+			}
+			if got, _ := BigRoundToInt(x, ToNearest, prec).Float64(); got != math.RoundToEven(v) {
+				t.Errorf("BigRoundToInt(%g, ToNearest) = %g, want %g", v, got, math.RoundToEven(v))
+			}
+		}
+	})
+}
+
+func TestBigRoundToDecimalPlaces(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-70", prec)
+
+	tests := []struct {
+		x      string
+		places int
+		mode   RoundingMode
+		want   string
+	}{
+		{"3.14159", 2, ToNearest, "3.14"},
+		{"3.14159", 4, ToNearest, "3.1416"},
+		{"-3.14159", 2, ToNearest, "-3.14"},
+		{"-3.14159", 4, ToNearest, "-3.1416"},
+		{"3.14159", 0, ToNearest, "3"},
+		{"3.14159", 4, ToZero, "3.1415"},
+		{"-3.14159", 4, ToZero, "-3.1415"},
+		{"3.14151", 4, AwayFromZero, "3.1416"},
+		{"-3.14151", 4, ToPositiveInf, "-3.1415"},
+		{"-3.14151", 4, ToNegativeInf, "-3.1416"},
+		// 0.125 and 2.675 are decimal ties only when scaled exactly
+		{"0.125", 2, ToNearest, "0.12"},
+		{"0.125", 2, ToNearestAway, "0.13"},
+		{"-0.125", 2, ToNearestAway, "-0.13"},
+		{"1234567.891", -3, ToNearest, "1235000"},
+		{"1500", -3, ToNearest, "2000"},
+		{"2500", -3, ToNearest, "2000"},
+		{"2500", -3, ToNearestAway, "3000"},
+		{"123456789.123456789123456789", 20, ToNearest, "123456789.12345678912345678900"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%d/%v", tt.x, tt.places, tt.mode), func(t *testing.T) {
+			x, _ := NewBigFloatFromString(tt.x, prec)
+			want, _ := NewBigFloatFromString(tt.want, prec)
+			got := BigRoundToDecimalPlaces(x, tt.places, tt.mode, prec)
+			if !BigFloatEqual(got, want, tol) {
+				t.Errorf("BigRoundToDecimalPlaces(%s, %d, %v) = %s, want %s", tt.x, tt.places, tt.mode, got.Text('f', 30), tt.want)
+			}
+		})
+	}
+
+	t.Run("binary_tie_is_exact", func(t *testing.T) {
+		// 2.675 as a float64 is slightly below the tie, so it rounds down
+		x := NewBigFloat(2.675, prec)
+		want, _ := NewBigFloatFromString("2.67", prec)
+		if got := BigRoundToDecimalPlaces(x, 2, ToNearestAway, prec); !BigFloatEqual(got, want, tol) {
+			t.Errorf("BigRoundToDecimalPlaces(float64 2.675, 2) = %s, want 2.67", got.Text('f', 20))
+		}
+	})
+
+	t.Run("signed_zero", func(t *testing.T) {
+		x, _ := NewBigFloatFromString("-0.00049", prec)
+		got := BigRoundToDecimalPlaces(x, 3, ToNearest, prec)
+		if got.Sign() != 0 || !got.Signbit() {
+			t.Errorf("BigRoundToDecimalPlaces(-0.00049, 3) = %s, want -0", got.Text('f', 5))
+		}
+	})
+}
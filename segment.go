@@ -48,8 +48,7 @@ func calculateOrbitalParametersMoon(tdiff *BigFloat, segInfo *SegmentInfoBig, pr
 	qrotPlusTdiff.Mul(tdiff, segInfo.DQrot)
 	qrotPlusTdiff.Add(segInfo.Qrot, qrotPlusTdiff)
 
-	cosdn := BigCos(dn, prec)
-	sindn := BigSin(dn, prec)
+	sindn, cosdn := BigSinCos(dn, prec)
 
 	qav = new(BigFloat).SetPrec(prec).Mul(qrotPlusTdiff, cosdn)
 	pav = new(BigFloat).SetPrec(prec).Mul(qrotPlusTdiff, sindn)
@@ -81,8 +80,7 @@ func addReferenceEllipse(x [][3]*BigFloat, segInfo *SegmentInfoBig, tdiff *BigFl
 	omtild.Add(segInfo.Peri, omtild)
 	omtild = reduceModulo2Pi(omtild, prec)
 
-	com := BigCos(omtild, prec)
-	som := BigSin(omtild, prec)
+	som, com := BigSinCos(omtild, prec)
 
 	if len(segInfo.RefEllipse) < 2*numCoeffs {
 		return
@@ -375,15 +373,76 @@ func ConvertToBigFloatCoeffs(coeffsFloat64 []float64, prec uint) []*BigFloat {
 	return result
 }
 
-// DebugPrintBigVec6 prints a BigVec6 for debugging
+// FitChebyshevBig computes ncoef Chebyshev coefficients of f over [segStart, segEnd]
+// f is sampled at the Chebyshev-Gauss nodes t_k = cos(π(k+½)/N) mapped into the
+// segment, and the coefficients follow from the discrete cosine transform
+// c_j = (2/N) Σ f(x_k) cos(πj(k+½)/N). The result uses the same convention as
+// EvaluateChebyshevBig (c_0 is halved on evaluation) and can be passed to it or,
+// per component, to EvaluateSegmentBig. Returns nil if ncoef <= 0.
+func FitChebyshevBig(f func(*BigFloat) *BigFloat, segStart, segEnd *BigFloat, ncoef int, prec uint) []*BigFloat {
+	if ncoef <= 0 {
+		return nil
+	}
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+
+	workPrec := prec + 32
+	n := NewBigFloat(float64(ncoef), workPrec)
+	pi := BigPI(workPrec)
+
+	// Segment midpoint and half-width
+	mid := new(BigFloat).SetPrec(workPrec).Add(segStart, segEnd)
+	mid.Quo(mid, NewBigFloat(2.0, workPrec))
+	half := new(BigFloat).SetPrec(workPrec).Sub(segEnd, segStart)
+	half.Quo(half, NewBigFloat(2.0, workPrec))
+
+	// Sample f at the mapped nodes
+	samples := make([]*BigFloat, ncoef)
+	for k := 0; k < ncoef; k++ {
+		theta := new(BigFloat).SetPrec(workPrec).Mul(pi, NewBigFloat(float64(k)+0.5, workPrec))
+		theta.Quo(theta, n)
+		x := new(BigFloat).SetPrec(workPrec).Mul(half, BigCos(theta, workPrec))
+		x.Add(x, mid)
+		samples[k] = f(new(BigFloat).SetPrec(prec).Set(x))
+	}
+
+	// Discrete cosine transform
+	coeffs := make([]*BigFloat, ncoef)
+	scale := new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(2.0, workPrec), n)
+	for j := 0; j < ncoef; j++ {
+		sum := NewBigFloat(0.0, workPrec)
+		for k := 0; k < ncoef; k++ {
+			theta := new(BigFloat).SetPrec(workPrec).Mul(pi, NewBigFloat(float64(j)*(float64(k)+0.5), workPrec))
+			theta.Quo(theta, n)
+			term := new(BigFloat).SetPrec(workPrec).Mul(samples[k], BigCos(theta, workPrec))
+			sum.Add(sum, term)
+		}
+		sum.Mul(sum, scale)
+		coeffs[j] = new(BigFloat).SetPrec(prec).Set(sum)
+	}
+
+	return coeffs
+}
+
+// DumpBigVec6 formats a BigVec6 with the requested number of significant digits
+// per component, without going through float64. digits <= 0 uses 16 digits,
+// matching the float64 %.15e output of earlier versions.
+func DumpBigVec6(v *BigVec6, digits int) string {
+	if digits <= 0 {
+		digits = 16
+	}
+
+	f := func(x *BigFloat) string {
+		return x.Text('e', digits-1)
+	}
+
+	return fmt.Sprintf("pos=[%s, %s, %s] vel=[%s, %s, %s]",
+		f(v.X), f(v.Y), f(v.Z), f(v.VX), f(v.VY), f(v.VZ))
+}
+
+// DebugPrintBigVec6 writes a BigVec6 to the package Logger for debugging
+// Use DumpBigVec6 to obtain the text with a custom number of digits
 func DebugPrintBigVec6(label string, v *BigVec6) {
-	x, _ := v.X.Float64()
-	y, _ := v.Y.Float64()
-	z, _ := v.Z.Float64()
-	vx, _ := v.VX.Float64()
-	vy, _ := v.VY.Float64()
-	vz, _ := v.VZ.Float64()
-
-	fmt.Printf("[%s] pos=[%.15e, %.15e, %.15e] vel=[%.15e, %.15e, %.15e]\n",
-		label, x, y, z, vx, vy, vz)
+	getLogger().Printf("[%s] %s\n", label, DumpBigVec6(v, 0))
 }
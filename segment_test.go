@@ -4,7 +4,11 @@
 package bigmath
 
 import (
+	"bytes"
+	"log"
 	"math"
+	"os"
+	"strings"
 	"testing"
 )
 
@@ -227,6 +231,91 @@ func TestDebugPrintBigVec6(t *testing.T) {
 
 	// This function just prints, so we just ensure it doesn't panic
 	DebugPrintBigVec6("test", v)
+
+	// Output is routed through the package logger
+	var buf bytes.Buffer
+	SetLogger(log.New(&buf, "", 0))
+	defer SetLogger(log.New(os.Stdout, "", 0))
+
+	DebugPrintBigVec6("captured", v)
+	want := "[captured] " + DumpBigVec6(v, 16) + "\n"
+	if buf.String() != want {
+		t.Errorf("DebugPrintBigVec6 logged %q, want %q", buf.String(), want)
+	}
+
+	// A nil logger silences output without panicking
+	SetLogger(nil)
+	DebugPrintBigVec6("silenced", v)
+}
+
+// TestDumpBigVec6 tests full-precision formatting of BigVec6
+func TestDumpBigVec6(t *testing.T) {
+	prec := uint(256)
+	third := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1.0, prec), NewBigFloat(3.0, prec))
+	v := &BigVec6{
+		X:  third,
+		Y:  new(BigFloat).SetPrec(prec).Neg(third),
+		Z:  BigPI(prec),
+		VX: BigE(prec),
+		VY: BigSqrt2(prec),
+		VZ: BigPhi(prec),
+	}
+
+	out := DumpBigVec6(v, 50)
+	if !strings.HasPrefix(out, "pos=[3.3333333333333333333333333333333333333333333333333e-01, ") {
+		t.Errorf("DumpBigVec6 X component not printed with 50 digits: %s", out)
+	}
+	if !strings.Contains(out, "3.1415926535897932384626433832795028841971693993751e+00") {
+		t.Errorf("DumpBigVec6 Z component not printed with 50 digits: %s", out)
+	}
+
+	// Every component has 50 significant digits in its mantissa
+	fields := strings.FieldsFunc(out, func(r rune) bool {
+		return r == '[' || r == ']' || r == ',' || r == ' '
+	})
+	count := 0
+	for _, field := range fields {
+		mant, _, found := strings.Cut(field, "e")
+		if !found || strings.Contains(field, "=") {
+			continue
+		}
+		digits := 0
+		for _, r := range mant {
+			if r >= '0' && r <= '9' {
+				digits++
+			}
+		}
+		if digits != 50 {
+			t.Errorf("component %q has %d digits, want 50", field, digits)
+		}
+		count++
+	}
+	if count != 6 {
+		t.Errorf("found %d components in %q, want 6", count, out)
+	}
+
+	t.Run("special_values", func(t *testing.T) {
+		special := &BigVec6{
+			X:  new(BigFloat).SetInf(false),
+			Y:  new(BigFloat).SetInf(true),
+			Z:  NewBigFloat(0.0, prec),
+			VX: NewBigFloat(0.0, prec),
+			VY: NewBigFloat(1.0, prec),
+			VZ: NewBigFloat(-1.0, prec),
+		}
+		out := DumpBigVec6(special, 20)
+		for _, want := range []string{"+Inf", "-Inf", "0.0000000000000000000e+00"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("DumpBigVec6 output %q missing %q", out, want)
+			}
+		}
+	})
+
+	t.Run("default_digits", func(t *testing.T) {
+		if got := DumpBigVec6(NewBigVec6(1, 2, 3, 4, 5, 6, prec), 0); !strings.Contains(got, "1.000000000000000e+00") {
+			t.Errorf("DumpBigVec6 with digits=0 = %q, want 16 significant digits", got)
+		}
+	})
 }
 
 // TestRotateCoeffsToJ2000Big tests coordinate rotation
@@ -584,3 +673,117 @@ func TestChebyshevAgainstDirectComputation(t *testing.T) {
 		t.Logf("Direct sum=%.15e, Clenshaw=%.15e, BigFloat=%.15e", direct, clenshaw, bigFloat)
 	})
 }
+
+// TestSegmentFunctionsDoNotPrint guards against diagnostics leaking to stdout
+func TestSegmentFunctionsDoNotPrint(t *testing.T) {
+	prec := uint(256)
+	zero := NewBigFloat(0.0, prec)
+	coeffs := []*BigFloat{
+		NewBigFloat(1.0, prec), NewBigFloat(0.1, prec), NewBigFloat(0.01, prec),
+		NewBigFloat(2.0, prec), NewBigFloat(0.2, prec), NewBigFloat(0.02, prec),
+		NewBigFloat(3.0, prec), NewBigFloat(0.3, prec), NewBigFloat(0.03, prec),
+	}
+	segInfo := &SegmentInfoBig{
+		SegmentStart: NewBigFloat(2451545.0, prec),
+		SegmentEnd:   NewBigFloat(2451577.0, prec),
+		SegmentSize:  NewBigFloat(32.0, prec),
+		ElemEpoch:    NewBigFloat(2451545.0, prec),
+		Qrot:         NewBigFloat(0.01, prec),
+		DQrot:        zero,
+		Prot:         NewBigFloat(0.02, prec),
+		DProt:        zero,
+		Peri:         zero,
+		DPeri:        zero,
+		NumCoeffs:    3,
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+
+	rotated, neval := RotateCoeffsToJ2000Big(coeffs, segInfo, false, prec)
+	EvaluateSegmentBig(NewBigFloat(2451561.0, prec), rotated, segInfo.SegmentStart, segInfo.SegmentEnd, neval, prec)
+
+	os.Stdout = stdout
+	_ = w.Close()
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r); err != nil {
+		t.Fatalf("reading captured stdout: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("segment evaluation wrote to stdout: %q", buf.String())
+	}
+}
+
+func TestFitChebyshevBig(t *testing.T) {
+	prec := uint(256)
+	ncoef := 20
+	segStart := NewBigFloat(0.0, prec)
+	segEnd := BigPI(prec)
+
+	sin := func(x *BigFloat) *BigFloat { return BigSin(x, prec) }
+	coeffs := FitChebyshevBig(sin, segStart, segEnd, ncoef, prec)
+	if len(coeffs) != ncoef {
+		t.Fatalf("FitChebyshevBig returned %d coefficients, want %d", len(coeffs), ncoef)
+	}
+
+	// sin is symmetric about π/2, so the odd coefficients vanish
+	oddTolerance, _ := NewBigFloatFromString("1e-60", prec)
+	for j := 1; j < ncoef; j += 2 {
+		if new(BigFloat).Abs(coeffs[j]).Cmp(oddTolerance) > 0 {
+			t.Errorf("coefficient %d = %s, want 0", j, coeffs[j].Text('g', 10))
+		}
+	}
+
+	// Lay the fit out as the X component of a segment
+	segCoeffs := make([]*BigFloat, 3*ncoef)
+	for i := range segCoeffs {
+		segCoeffs[i] = NewBigFloat(0.0, prec)
+	}
+	copy(segCoeffs, coeffs)
+
+	evalAt := func(x *BigFloat) *BigVec6 {
+		return EvaluateSegmentBig(x, segCoeffs, segStart, segEnd, ncoef, prec)
+	}
+
+	t.Run("reproduces_samples", func(t *testing.T) {
+		tolerance, _ := NewBigFloatFromString("1e-35", prec)
+		pi := BigPI(prec)
+		halfPi := BigHalfPI(prec)
+		for k := 0; k < ncoef; k++ {
+			theta := new(BigFloat).SetPrec(prec).Mul(pi, NewBigFloat(float64(k)+0.5, prec))
+			theta.Quo(theta, NewBigFloat(float64(ncoef), prec))
+			x := new(BigFloat).SetPrec(prec).Mul(halfPi, BigCos(theta, prec))
+			x.Add(x, halfPi)
+
+			diff := new(BigFloat).SetPrec(prec).Sub(evalAt(x).X, sin(x))
+			if diff.Abs(diff).Cmp(tolerance) > 0 {
+				t.Errorf("node %d: fit - sin = %s, want within 1e-35", k, diff.Text('g', 10))
+			}
+		}
+	})
+
+	t.Run("between_nodes", func(t *testing.T) {
+		// Truncation error of a 20-term fit of sin over [0, π] is below 1e-19
+		for _, v := range []float64{0.1, 1.0, 2.2, 3.0} {
+			x := NewBigFloat(v, prec)
+			r := evalAt(x)
+			diff := new(BigFloat).SetPrec(prec).Sub(r.X, sin(x))
+			if got, _ := diff.Float64(); math.Abs(got) > 1e-19 {
+				t.Errorf("fit(%g) - sin(%g) = %g, want within 1e-19", v, v, got)
+			}
+			// The velocity is the derivative of the fit
+			dv := new(BigFloat).SetPrec(prec).Sub(r.VX, BigCos(x, prec))
+			if got, _ := dv.Float64(); math.Abs(got) > 1e-16 {
+				t.Errorf("fit'(%g) - cos(%g) = %g, want within 1e-16", v, v, got)
+			}
+		}
+	})
+
+	if FitChebyshevBig(sin, segStart, segEnd, 0, prec) != nil {
+		t.Error("FitChebyshevBig with ncoef = 0 should return nil")
+	}
+}
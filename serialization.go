@@ -4,6 +4,7 @@
 package bigmath
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -33,16 +34,50 @@ func BigFloatUnmarshalJSON(data []byte, prec uint) (*BigFloat, error) {
 	return NewBigFloatFromString(s, prec)
 }
 
+// jsonBigFloat is the JSON form of a vector or matrix component
+// Value is produced by Text('g', -1), the shortest decimal that parses back to
+// the same value at Prec bits, so a round trip is exact. Plain strings without
+// a precision are accepted on input for compatibility with older encodings.
+type jsonBigFloat struct {
+	Value string `json:"value"`
+	Prec  uint   `json:"prec"`
+}
+
+func newJSONBigFloat(x *BigFloat) jsonBigFloat {
+	return jsonBigFloat{Value: x.Text('g', -1), Prec: x.Prec()}
+}
+
+// UnmarshalJSON implements json.Unmarshaler for jsonBigFloat
+func (j *jsonBigFloat) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		j.Prec = 0
+		return json.Unmarshal(data, &j.Value)
+	}
+
+	type plain jsonBigFloat
+	return json.Unmarshal(data, (*plain)(j))
+}
+
+// bigFloat parses j at its stored precision, or at defaultPrec if none was stored
+func (j jsonBigFloat) bigFloat(defaultPrec uint) (*BigFloat, error) {
+	prec := j.Prec
+	if prec == 0 {
+		prec = defaultPrec
+	}
+	return NewBigFloatFromString(j.Value, prec)
+}
+
 // MarshalJSON implements json.Marshaler for BigVec3
+// Each component is written as its exact decimal value together with its precision.
 func (v *BigVec3) MarshalJSON() ([]byte, error) {
 	if v == nil {
 		return []byte("null"), nil
 	}
 
-	return json.Marshal([3]string{
-		v.X.Text('g', -1),
-		v.Y.Text('g', -1),
-		v.Z.Text('g', -1),
+	return json.Marshal([3]jsonBigFloat{
+		newJSONBigFloat(v.X),
+		newJSONBigFloat(v.Y),
+		newJSONBigFloat(v.Z),
 	})
 }
 
@@ -52,7 +87,63 @@ func (v *BigVec3) UnmarshalJSON(data []byte) error {
 		return errors.New("cannot unmarshal into nil BigVec3")
 	}
 
-	var arr [3]string
+	var arr [3]jsonBigFloat
+	if err := json.Unmarshal(data, &arr); err != nil {
+		return err
+	}
+
+	var prec uint = DefaultPrecision
+	if v.X != nil {
+		prec = v.X.Prec()
+	}
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+
+	x, err := arr[0].bigFloat(prec)
+	if err != nil {
+		return fmt.Errorf("invalid X component: %w", err)
+	}
+
+	y, err := arr[1].bigFloat(prec)
+	if err != nil {
+		return fmt.Errorf("invalid Y component: %w", err)
+	}
+
+	z, err := arr[2].bigFloat(prec)
+	if err != nil {
+		return fmt.Errorf("invalid Z component: %w", err)
+	}
+
+	v.X = x
+	v.Y = y
+	v.Z = z
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for BigVec4
+// Each component is written as its exact decimal value together with its precision.
+func (v *BigVec4) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal([4]jsonBigFloat{
+		newJSONBigFloat(v.X),
+		newJSONBigFloat(v.Y),
+		newJSONBigFloat(v.Z),
+		newJSONBigFloat(v.W),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler for BigVec4
+func (v *BigVec4) UnmarshalJSON(data []byte) error {
+	if v == nil {
+		return errors.New("cannot unmarshal into nil BigVec4")
+	}
+
+	var arr [4]jsonBigFloat
 	if err := json.Unmarshal(data, &arr); err != nil {
 		return err
 	}
@@ -65,41 +156,48 @@ func (v *BigVec3) UnmarshalJSON(data []byte) error {
 		prec = DefaultPrecision
 	}
 
-	x, err := NewBigFloatFromString(arr[0], prec)
+	x, err := arr[0].bigFloat(prec)
 	if err != nil {
 		return fmt.Errorf("invalid X component: %w", err)
 	}
 
-	y, err := NewBigFloatFromString(arr[1], prec)
+	y, err := arr[1].bigFloat(prec)
 	if err != nil {
 		return fmt.Errorf("invalid Y component: %w", err)
 	}
 
-	z, err := NewBigFloatFromString(arr[2], prec)
+	z, err := arr[2].bigFloat(prec)
 	if err != nil {
 		return fmt.Errorf("invalid Z component: %w", err)
 	}
 
+	w, err := arr[3].bigFloat(prec)
+	if err != nil {
+		return fmt.Errorf("invalid W component: %w", err)
+	}
+
 	v.X = x
 	v.Y = y
 	v.Z = z
+	v.W = w
 
 	return nil
 }
 
 // MarshalJSON implements json.Marshaler for BigVec6
+// Each component is written as its exact decimal value together with its precision.
 func (v *BigVec6) MarshalJSON() ([]byte, error) {
 	if v == nil {
 		return []byte("null"), nil
 	}
 
-	return json.Marshal([6]string{
-		v.X.Text('g', -1),
-		v.Y.Text('g', -1),
-		v.Z.Text('g', -1),
-		v.VX.Text('g', -1),
-		v.VY.Text('g', -1),
-		v.VZ.Text('g', -1),
+	return json.Marshal([6]jsonBigFloat{
+		newJSONBigFloat(v.X),
+		newJSONBigFloat(v.Y),
+		newJSONBigFloat(v.Z),
+		newJSONBigFloat(v.VX),
+		newJSONBigFloat(v.VY),
+		newJSONBigFloat(v.VZ),
 	})
 }
 
@@ -109,7 +207,7 @@ func (v *BigVec6) UnmarshalJSON(data []byte) error {
 		return errors.New("cannot unmarshal into nil BigVec6")
 	}
 
-	var arr [6]string
+	var arr [6]jsonBigFloat
 	if err := json.Unmarshal(data, &arr); err != nil {
 		return err
 	}
@@ -122,32 +220,32 @@ func (v *BigVec6) UnmarshalJSON(data []byte) error {
 		prec = DefaultPrecision
 	}
 
-	x, err := NewBigFloatFromString(arr[0], prec)
+	x, err := arr[0].bigFloat(prec)
 	if err != nil {
 		return fmt.Errorf("invalid X component: %w", err)
 	}
 
-	y, err := NewBigFloatFromString(arr[1], prec)
+	y, err := arr[1].bigFloat(prec)
 	if err != nil {
 		return fmt.Errorf("invalid Y component: %w", err)
 	}
 
-	z, err := NewBigFloatFromString(arr[2], prec)
+	z, err := arr[2].bigFloat(prec)
 	if err != nil {
 		return fmt.Errorf("invalid Z component: %w", err)
 	}
 
-	vx, err := NewBigFloatFromString(arr[3], prec)
+	vx, err := arr[3].bigFloat(prec)
 	if err != nil {
 		return fmt.Errorf("invalid VX component: %w", err)
 	}
 
-	vy, err := NewBigFloatFromString(arr[4], prec)
+	vy, err := arr[4].bigFloat(prec)
 	if err != nil {
 		return fmt.Errorf("invalid VY component: %w", err)
 	}
 
-	vz, err := NewBigFloatFromString(arr[5], prec)
+	vz, err := arr[5].bigFloat(prec)
 	if err != nil {
 		return fmt.Errorf("invalid VZ component: %w", err)
 	}
@@ -163,15 +261,16 @@ func (v *BigVec6) UnmarshalJSON(data []byte) error {
 }
 
 // MarshalJSON implements json.Marshaler for BigMatrix3x3
+// Each element is written as its exact decimal value together with its precision.
 func (m *BigMatrix3x3) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return []byte("null"), nil
 	}
 
-	matrix := [3][3]string{}
+	matrix := [3][3]jsonBigFloat{}
 	for i := 0; i < 3; i++ {
 		for j := 0; j < 3; j++ {
-			matrix[i][j] = m.M[i][j].Text('g', -1)
+			matrix[i][j] = newJSONBigFloat(m.M[i][j])
 		}
 	}
 
@@ -184,7 +283,7 @@ func (m *BigMatrix3x3) UnmarshalJSON(data []byte) error {
 		return errors.New("cannot unmarshal into nil BigMatrix3x3")
 	}
 
-	var matrix [3][3]string
+	var matrix [3][3]jsonBigFloat
 	if err := json.Unmarshal(data, &matrix); err != nil {
 		return err
 	}
@@ -199,18 +298,142 @@ func (m *BigMatrix3x3) UnmarshalJSON(data []byte) error {
 
 	for i := 0; i < 3; i++ {
 		for j := 0; j < 3; j++ {
-			val, err := NewBigFloatFromString(matrix[i][j], prec)
+			val, err := matrix[i][j].bigFloat(prec)
 			if err != nil {
 				return fmt.Errorf("invalid element [%d][%d]: %w", i, j, err)
 			}
-			if m.M[i][j] == nil {
-				m.M[i][j] = val
-			} else {
-				m.M[i][j].Set(val)
-			}
+			m.M[i][j] = val
+		}
+	}
+
+	return nil
+}
+
+// marshalBigFloats encodes each value with big.Float's GobEncode, which keeps
+// the exact mantissa, exponent, precision and rounding mode, prefixing every
+// encoding with its length as a big-endian uint32
+func marshalBigFloats(vals []*BigFloat, names []string) ([]byte, error) {
+	var buf []byte
+	for i, x := range vals {
+		if x == nil {
+			return nil, fmt.Errorf("%s component is nil", names[i])
+		}
+		enc, err := x.GobEncode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s component: %w", names[i], err)
+		}
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(enc)))
+		buf = append(buf, enc...)
+	}
+	return buf, nil
+}
+
+// unmarshalBigFloats decodes len(names) values written by marshalBigFloats
+func unmarshalBigFloats(data []byte, names []string) ([]*BigFloat, error) {
+	vals := make([]*BigFloat, len(names))
+	for i := range vals {
+		if len(data) < 4 {
+			return nil, fmt.Errorf("truncated data before %s component", names[i])
+		}
+		n := binary.BigEndian.Uint32(data)
+		data = data[4:]
+		if uint64(len(data)) < uint64(n) {
+			return nil, fmt.Errorf("truncated %s component", names[i])
 		}
+		x := new(BigFloat)
+		if err := x.GobDecode(data[:n]); err != nil {
+			return nil, fmt.Errorf("invalid %s component: %w", names[i], err)
+		}
+		vals[i] = x
+		data = data[n:]
+	}
+	if len(data) != 0 {
+		return nil, fmt.Errorf("%d trailing bytes after last component", len(data))
+	}
+	return vals, nil
+}
+
+var (
+	vec3ComponentNames = []string{"X", "Y", "Z"}
+	vec6ComponentNames = []string{"X", "Y", "Z", "VX", "VY", "VZ"}
+	matrixElementNames = []string{
+		"[0][0]", "[0][1]", "[0][2]",
+		"[1][0]", "[1][1]", "[1][2]",
+		"[2][0]", "[2][1]", "[2][2]",
+	}
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler for BigVec3
+// Unlike MarshalJSON, the encoding keeps each component's precision, so a
+// round trip through UnmarshalBinary is bit-exact.
+func (v *BigVec3) MarshalBinary() ([]byte, error) {
+	if v == nil {
+		return nil, errors.New("cannot marshal nil BigVec3")
+	}
+	return marshalBigFloats([]*BigFloat{v.X, v.Y, v.Z}, vec3ComponentNames)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for BigVec3
+func (v *BigVec3) UnmarshalBinary(data []byte) error {
+	if v == nil {
+		return errors.New("cannot unmarshal into nil BigVec3")
+	}
+	c, err := unmarshalBigFloats(data, vec3ComponentNames)
+	if err != nil {
+		return err
+	}
+	v.X, v.Y, v.Z = c[0], c[1], c[2]
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for BigVec6
+func (v *BigVec6) MarshalBinary() ([]byte, error) {
+	if v == nil {
+		return nil, errors.New("cannot marshal nil BigVec6")
+	}
+	return marshalBigFloats([]*BigFloat{v.X, v.Y, v.Z, v.VX, v.VY, v.VZ}, vec6ComponentNames)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for BigVec6
+func (v *BigVec6) UnmarshalBinary(data []byte) error {
+	if v == nil {
+		return errors.New("cannot unmarshal into nil BigVec6")
+	}
+	c, err := unmarshalBigFloats(data, vec6ComponentNames)
+	if err != nil {
+		return err
 	}
+	v.X, v.Y, v.Z, v.VX, v.VY, v.VZ = c[0], c[1], c[2], c[3], c[4], c[5]
+	return nil
+}
 
+// MarshalBinary implements encoding.BinaryMarshaler for BigMatrix3x3
+// Elements are written in row-major order.
+func (m *BigMatrix3x3) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, errors.New("cannot marshal nil BigMatrix3x3")
+	}
+	vals := make([]*BigFloat, 0, 9)
+	for i := 0; i < 3; i++ {
+		vals = append(vals, m.M[i][:]...)
+	}
+	return marshalBigFloats(vals, matrixElementNames)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for BigMatrix3x3
+func (m *BigMatrix3x3) UnmarshalBinary(data []byte) error {
+	if m == nil {
+		return errors.New("cannot unmarshal into nil BigMatrix3x3")
+	}
+	vals, err := unmarshalBigFloats(data, matrixElementNames)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m.M[i][j] = vals[3*i+j]
+		}
+	}
 	return nil
 }
 
@@ -246,3 +469,166 @@ func ReadDoubleAsBigFloat(r io.Reader, bigEndian bool, prec uint) (*BigFloat, er
 	// Falls back to generic implementation on unsupported platforms
 	return readDoubleAsBigFloatImpl(r, bigEndian, prec)
 }
+
+// ReadDoublesAsBigFloat reads n consecutive IEEE 754 doubles from the reader in a
+// single ReadFull and converts each to BigFloat exactly, as ReadDoubleAsBigFloat does.
+// The results share one backing array, which keeps allocations low for large
+// coefficient blocks.
+//
+// On a short read the values decoded from the complete doubles read so far are
+// returned together with an error reporting how many were decoded.
+//
+// Parameters:
+//   - r: io.Reader to read n*8 bytes from
+//   - n: number of doubles to read
+//   - bigEndian: true for big-endian byte order, false for little-endian
+//   - prec: BigFloat precision in bits (0 uses DefaultPrecision)
+func ReadDoublesAsBigFloat(r io.Reader, n int, bigEndian bool, prec uint) ([]*BigFloat, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("negative count %d", n)
+	}
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+
+	buf := make([]byte, 8*n)
+	read, readErr := io.ReadFull(r, buf)
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if bigEndian {
+		order = binary.BigEndian
+	}
+
+	count := read / 8
+	vals := make([]BigFloat, count)
+	result := make([]*BigFloat, count)
+	for i := range vals {
+		decodeDoubleBits(&vals[i], order.Uint64(buf[8*i:]), prec)
+		result[i] = &vals[i]
+	}
+
+	if readErr != nil {
+		return result, fmt.Errorf("failed to read %d doubles, decoded %d: %w", n, count, readErr)
+	}
+	return result, nil
+}
+
+// decodeDoubleBits sets z to the exact value of the IEEE 754 double with the given
+// bits at precision prec, mapping NaN to zero
+func decodeDoubleBits(z *BigFloat, bits uint64, prec uint) {
+	decodeIEEEBits(z, bits, 11, 52, prec)
+}
+
+// decodeIEEEBits sets z to the exact value of the IEEE 754 binary number in the
+// low 1+expBits+mantBits bits of bits at precision prec, mapping NaN to zero.
+// Zeros keep their sign and subnormals are decoded exactly.
+func decodeIEEEBits(z *BigFloat, bits uint64, expBits, mantBits uint, prec uint) {
+	maxExp := 1<<expBits - 1
+	bias := 1<<(expBits-1) - 1
+
+	sign := (bits>>(expBits+mantBits))&1 != 0
+	exponent := int(bits>>mantBits) & maxExp
+	mantissa := bits & (1<<mantBits - 1)
+
+	z.SetPrec(prec)
+	switch {
+	case exponent == maxExp && mantissa == 0:
+		z.SetInf(sign)
+		return
+	case exponent == maxExp:
+		// NaN: big.Float has no NaN, so return zero
+		z.SetUint64(0)
+		return
+	case exponent == 0:
+		// Zero or subnormal: (mantissa / 2^mantBits) * 2^(1-bias)
+		z.SetUint64(mantissa)
+		z.SetMantExp(z, 1-bias-int(mantBits))
+	default:
+		// Normalized: (1 + mantissa / 2^mantBits) * 2^(exponent-bias)
+		z.SetUint64(mantissa | 1<<mantBits)
+		z.SetMantExp(z, exponent-bias-int(mantBits))
+	}
+
+	if sign {
+		z.Neg(z)
+	}
+}
+
+// ReadFloat32AsBigFloat reads 4 bytes from the reader and converts them directly to
+// BigFloat, decoding the IEEE 754 single precision layout without an intermediate
+// float32 or float64:
+// - 1 sign bit
+// - 8 exponent bits
+// - 23 mantissa bits (with implicit leading 1 for normalized numbers)
+//
+// Zeros keep their sign, subnormals are decoded exactly, infinities map to
+// ±Inf and NaN maps to zero, as in ReadDoubleAsBigFloat.
+//
+// Parameters:
+//   - r: io.Reader to read 4 bytes from
+//   - bigEndian: true for big-endian byte order, false for little-endian
+//   - prec: BigFloat precision in bits (0 uses DefaultPrecision)
+func ReadFloat32AsBigFloat(r io.Reader, bigEndian bool, prec uint) (*BigFloat, error) {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+
+	var floatBytes [4]byte
+	if _, err := io.ReadFull(r, floatBytes[:]); err != nil {
+		return nil, fmt.Errorf("failed to read 4 bytes: %w", err)
+	}
+
+	var bits uint32
+	if bigEndian {
+		bits = binary.BigEndian.Uint32(floatBytes[:])
+	} else {
+		bits = binary.LittleEndian.Uint32(floatBytes[:])
+	}
+
+	result := new(BigFloat)
+	decodeIEEEBits(result, uint64(bits), 8, 23, prec)
+	return result, nil
+}
+
+// ReadBigVec3 reads three consecutive IEEE 754 doubles (X, Y, Z) from the reader
+// and converts them to a BigVec3 using ReadDoubleAsBigFloat, so every component
+// keeps the full 53-bit precision of the source data.
+//
+// Parameters:
+//   - r: io.Reader to read 24 bytes from
+//   - bigEndian: true for big-endian byte order, false for little-endian
+//   - prec: BigFloat precision in bits (0 uses DefaultPrecision)
+func ReadBigVec3(r io.Reader, bigEndian bool, prec uint) (*BigVec3, error) {
+	var c [3]*BigFloat
+	names := [3]string{"X", "Y", "Z"}
+	for i := range c {
+		val, err := ReadDoubleAsBigFloat(r, bigEndian, prec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s component: %w", names[i], err)
+		}
+		c[i] = val
+	}
+
+	return &BigVec3{X: c[0], Y: c[1], Z: c[2]}, nil
+}
+
+// ReadBigVec6 reads six consecutive IEEE 754 doubles (X, Y, Z, VX, VY, VZ) from
+// the reader and converts them to a BigVec6 state vector using ReadDoubleAsBigFloat.
+//
+// Parameters:
+//   - r: io.Reader to read 48 bytes from
+//   - bigEndian: true for big-endian byte order, false for little-endian
+//   - prec: BigFloat precision in bits (0 uses DefaultPrecision)
+func ReadBigVec6(r io.Reader, bigEndian bool, prec uint) (*BigVec6, error) {
+	var c [6]*BigFloat
+	names := [6]string{"X", "Y", "Z", "VX", "VY", "VZ"}
+	for i := range c {
+		val, err := ReadDoubleAsBigFloat(r, bigEndian, prec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s component: %w", names[i], err)
+		}
+		c[i] = val
+	}
+
+	return &BigVec6{X: c[0], Y: c[1], Z: c[2], VX: c[3], VY: c[4], VZ: c[5]}, nil
+}
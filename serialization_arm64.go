@@ -59,12 +59,14 @@ func init() {
 }
 
 // handleZeroOrDenormalizedARM64 handles zero and denormalized numbers
-func handleZeroOrDenormalizedARM64(sign bool, prec uint) *BigFloat {
-	result := new(big.Float).SetPrec(prec)
-	result.Set(cachedZeroARM64)
+func handleZeroOrDenormalizedARM64(sign bool, mantissa uint64, prec uint) *BigFloat {
+	// A zero exponent field encodes a signed zero or a subnormal
+	bits := mantissa
 	if sign {
-		result.Neg(result)
+		bits |= 1 << 63
 	}
+	result := new(BigFloat)
+	decodeDoubleBits(result, bits, prec)
 	return result
 }
 
@@ -170,7 +172,7 @@ func readDoubleAsBigFloatAsm(r io.Reader, bigEndian bool, prec uint) (*BigFloat,
 
 	// Handle special cases
 	if exponent == 0 {
-		return handleZeroOrDenormalizedARM64(sign, prec), nil
+		return handleZeroOrDenormalizedARM64(sign, mantissa, prec), nil
 	}
 
 	if exponent == 0x7FF {
@@ -7,10 +7,13 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math"
 	"math/big"
+	"math/rand"
+	"strings"
 	"testing"
 )
 
@@ -165,6 +168,77 @@ func TestBigVec6JSON(t *testing.T) {
 	})
 }
 
+func TestBigVec4JSON(t *testing.T) {
+	prec := uint(256)
+
+	tests := []struct {
+		name string
+		v    *BigVec4
+	}{
+		{"normal_values", NewBigVec4(1.0, 2.0, 3.0, 1.0, prec)},
+		{"zero_vector", NewBigVec4(0.0, 0.0, 0.0, 0.0, prec)},
+		{"negative_values", NewBigVec4(-1.0, -2.0, -3.0, -0.5, prec)},
+		{"very_large", NewBigVec4(1e10, 2e10, 3e10, 4e10, prec)},
+		{"very_small", NewBigVec4(1e-10, 2e-10, 3e-10, 4e-10, prec)},
+		{"third", &BigVec4{
+			X: new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1.0, prec), NewBigFloat(3.0, prec)),
+			Y: NewBigFloat(0.1, prec), Z: NewBigFloat(-7.0, prec), W: NewBigFloat(1.0, prec),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.v)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+
+			var v2 BigVec4
+			if err := json.Unmarshal(data, &v2); err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+
+			want := []*BigFloat{tt.v.X, tt.v.Y, tt.v.Z, tt.v.W}
+			for i, g := range []*BigFloat{v2.X, v2.Y, v2.Z, v2.W} {
+				if g.Cmp(want[i]) != 0 || g.Prec() != want[i].Prec() {
+					t.Errorf("Component[%d] = %s (prec %d), want %s (prec %d)",
+						i, g.Text('g', 30), g.Prec(), want[i].Text('g', 30), want[i].Prec())
+				}
+			}
+		})
+	}
+
+	// Test invalid JSON
+	t.Run("invalid_json", func(t *testing.T) {
+		invalidJSON := []byte(`{"X": "not a number", "Y": 2.0, "Z": 3.0, "W": 1.0}`)
+		var v BigVec4
+		if err := json.Unmarshal(invalidJSON, &v); err == nil {
+			t.Error("Unmarshal should fail for invalid JSON")
+		}
+	})
+
+	// Test precision preservation
+	t.Run("precision_preservation", func(t *testing.T) {
+		testCases := []uint{64, 128, 256, 512}
+		for _, p := range testCases {
+			v := NewBigVec4(1.0, 2.0, 3.0, 4.0, p)
+			data, err := json.Marshal(v)
+			if err != nil {
+				t.Fatalf("Marshal failed at prec %d: %v", p, err)
+			}
+
+			var v2 BigVec4
+			if err := json.Unmarshal(data, &v2); err != nil {
+				t.Fatalf("Unmarshal failed at prec %d: %v", p, err)
+			}
+
+			if v2.W.Prec() != p {
+				t.Errorf("Precision not preserved: orig %d, unmarshaled %d", p, v2.W.Prec())
+			}
+		}
+	})
+}
+
 func TestBigMatrix3x3JSON(t *testing.T) {
 	prec := uint(256)
 
@@ -266,6 +340,180 @@ func TestBigMatrix3x3JSON(t *testing.T) {
 	})
 }
 
+// TestBigVecJSONExact tests that JSON round trips are bit-exact and keep precision
+func TestBigVecJSONExact(t *testing.T) {
+	third := func(prec uint) *BigFloat {
+		return new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1.0, prec), NewBigFloat(3.0, prec))
+	}
+	huge, _ := NewBigFloatFromString("-6.02214076e+23456", 200)
+	tiny := new(BigFloat).SetPrec(1000).Quo(NewBigFloat(2.0, 1000), NewBigFloat(7e300, 1000))
+
+	t.Run("BigVec3", func(t *testing.T) {
+		v := &BigVec3{X: third(512), Y: huge, Z: tiny}
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		var got BigVec3
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		for i, pair := range [][2]*BigFloat{{v.X, got.X}, {v.Y, got.Y}, {v.Z, got.Z}} {
+			if pair[0].Cmp(pair[1]) != 0 || pair[0].Prec() != pair[1].Prec() {
+				t.Errorf("component %d: got %s (prec %d), want %s (prec %d)",
+					i, pair[1].Text('g', 30), pair[1].Prec(), pair[0].Text('g', 30), pair[0].Prec())
+			}
+		}
+	})
+
+	t.Run("BigVec6", func(t *testing.T) {
+		v := &BigVec6{X: third(64), Y: third(128), Z: third(256), VX: third(512), VY: huge, VZ: tiny}
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		var got BigVec6
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		want := []*BigFloat{v.X, v.Y, v.Z, v.VX, v.VY, v.VZ}
+		for i, g := range []*BigFloat{got.X, got.Y, got.Z, got.VX, got.VY, got.VZ} {
+			if g.Cmp(want[i]) != 0 || g.Prec() != want[i].Prec() {
+				t.Errorf("component %d: got %s (prec %d), want %s (prec %d)",
+					i, g.Text('g', 30), g.Prec(), want[i].Text('g', 30), want[i].Prec())
+			}
+		}
+	})
+
+	t.Run("BigMatrix3x3", func(t *testing.T) {
+		m := &BigMatrix3x3{}
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				m.M[i][j] = new(BigFloat).SetPrec(384).Quo(NewBigFloat(float64(i+1), 384), NewBigFloat(float64(3*i+j+7), 384))
+			}
+		}
+		data, err := json.Marshal(m)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		var got BigMatrix3x3
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				if got.M[i][j].Cmp(m.M[i][j]) != 0 || got.M[i][j].Prec() != 384 {
+					t.Errorf("M[%d][%d] = %s (prec %d), want %s", i, j,
+						got.M[i][j].Text('g', 30), got.M[i][j].Prec(), m.M[i][j].Text('g', 30))
+				}
+			}
+		}
+	})
+
+	t.Run("legacy_string_components", func(t *testing.T) {
+		var v BigVec3
+		if err := json.Unmarshal([]byte(`["1.5", "-2", "3e-10"]`), &v); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if v.X.Prec() != DefaultPrecision || !BigEqualFloat64(v.X, 1.5) || !BigEqualFloat64(v.Y, -2.0) {
+			t.Errorf("got (%s, %s) at prec %d, want (1.5, -2) at prec %d",
+				v.X.Text('g', 10), v.Y.Text('g', 10), v.X.Prec(), DefaultPrecision)
+		}
+	})
+}
+
+// TestBigVecBinaryRoundTrip tests that MarshalBinary/UnmarshalBinary are bit-exact
+func TestBigVecBinaryRoundTrip(t *testing.T) {
+	prec := uint(512)
+	mustParse := func(s string) *BigFloat {
+		x, err := NewBigFloatFromString(s, prec)
+		if err != nil {
+			t.Fatalf("NewBigFloatFromString(%q) failed: %v", s, err)
+		}
+		return x
+	}
+
+	// 100 significant digits, none representable as float64
+	x := mustParse("1.234567890123456789012345678901234567890123456789012345678901234567890123456789012345678901234567891")
+	y := mustParse("-9.876543210987654321098765432109876543210987654321098765432109876543210987654321098765432109876543211e-300")
+	z := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1.0, prec), NewBigFloat(3.0, prec))
+
+	t.Run("BigVec3", func(t *testing.T) {
+		v := &BigVec3{X: x, Y: y, Z: z}
+		data, err := v.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary failed: %v", err)
+		}
+		var got BigVec3
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary failed: %v", err)
+		}
+		for i, pair := range [][2]*BigFloat{{v.X, got.X}, {v.Y, got.Y}, {v.Z, got.Z}} {
+			if pair[0].Cmp(pair[1]) != 0 || pair[1].Prec() != prec {
+				t.Errorf("component %d: got %s (prec %d), want %s (prec %d)",
+					i, pair[1].Text('g', 40), pair[1].Prec(), pair[0].Text('g', 40), prec)
+			}
+		}
+	})
+
+	t.Run("BigVec6", func(t *testing.T) {
+		v := &BigVec6{X: x, Y: y, Z: z, VX: z, VY: y, VZ: x}
+		data, err := v.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary failed: %v", err)
+		}
+		var got BigVec6
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary failed: %v", err)
+		}
+		want := []*BigFloat{v.X, v.Y, v.Z, v.VX, v.VY, v.VZ}
+		for i, g := range []*BigFloat{got.X, got.Y, got.Z, got.VX, got.VY, got.VZ} {
+			if g.Cmp(want[i]) != 0 {
+				t.Errorf("component %d: got %s, want %s", i, g.Text('g', 40), want[i].Text('g', 40))
+			}
+		}
+	})
+
+	t.Run("BigMatrix3x3", func(t *testing.T) {
+		m := &BigMatrix3x3{}
+		vals := []*BigFloat{x, y, z}
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				m.M[i][j] = vals[(i+j)%3]
+			}
+		}
+		data, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary failed: %v", err)
+		}
+		var got BigMatrix3x3
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary failed: %v", err)
+		}
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				if got.M[i][j].Cmp(m.M[i][j]) != 0 {
+					t.Errorf("M[%d][%d]: got %s, want %s", i, j, got.M[i][j].Text('g', 40), m.M[i][j].Text('g', 40))
+				}
+			}
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := (&BigVec3{X: x, Y: y}).MarshalBinary(); err == nil {
+			t.Error("MarshalBinary with nil component should fail")
+		}
+		data, _ := (&BigVec3{X: x, Y: y, Z: z}).MarshalBinary()
+		var v BigVec3
+		if err := v.UnmarshalBinary(data[:len(data)-1]); err == nil {
+			t.Error("UnmarshalBinary of truncated data should fail")
+		}
+		if err := v.UnmarshalBinary(append(data, 0)); err == nil {
+			t.Error("UnmarshalBinary with trailing bytes should fail")
+		}
+	})
+}
+
 // TestBigFloatMarshalJSON tests BigFloat JSON marshaling
 func TestBigFloatMarshalJSON(t *testing.T) {
 	prec := uint(256)
@@ -432,10 +680,12 @@ func TestReadDoubleAsBigFloat(t *testing.T) {
 		{"negative_small_big_endian", -1e-50, true, 1e-65},
 		{"max_float64_little_endian", math.MaxFloat64, false, 1e292},
 		{"max_float64_big_endian", math.MaxFloat64, true, 1e292},
-		// Note: math.SmallestNonzeroFloat64 is denormalized, which the function handles as zero (see TODO in code)
-		// Use smallest normalized number instead: 2^-1022
 		{"smallest_normal_little_endian", math.Pow(2, -1022), false, 1e-330},
 		{"smallest_normal_big_endian", math.Pow(2, -1022), true, 1e-330},
+		{"smallest_subnormal_little_endian", math.SmallestNonzeroFloat64, false, 0},
+		{"smallest_subnormal_big_endian", math.SmallestNonzeroFloat64, true, 0},
+		{"subnormal_1e-320_little_endian", 1e-320, false, 0},
+		{"negative_subnormal_big_endian", -1e-315, true, 0},
 	}
 
 	for _, tt := range tests {
@@ -560,8 +810,7 @@ func TestReadDoubleAsBigFloatSpecialCases(t *testing.T) {
 
 	// Test denormalized number (subnormal)
 	t.Run("denormalized_little_endian", func(t *testing.T) {
-		// Create a denormalized number: exponent=0, mantissa != 0
-		// Smallest denormalized: 0x0000000000000001
+		// Smallest denormalized: 0x0000000000000001 = 2^-1074
 		bits := uint64(0x0000000000000001)
 		var buf bytes.Buffer
 		binary.Write(&buf, binary.LittleEndian, bits)
@@ -570,15 +819,19 @@ func TestReadDoubleAsBigFloatSpecialCases(t *testing.T) {
 		if err != nil {
 			t.Fatalf("ReadDoubleAsBigFloat failed: %v", err)
 		}
-		// Currently denormalized numbers are handled as zero (see TODO in code)
-		if result.Sign() != 0 {
-			val, _ := result.Float64()
-			t.Errorf("ReadDoubleAsBigFloat(denormalized) = %v, want zero (denormalized handling TODO)", val)
+		want := new(big.Float).SetMantExp(big.NewFloat(1), -1074)
+		if result.Cmp(want) != 0 {
+			t.Errorf("ReadDoubleAsBigFloat(denormalized) = %s, want 2^-1074", result.Text('g', 20))
+		}
+		relErr := new(big.Float).Sub(result, big.NewFloat(math.SmallestNonzeroFloat64))
+		relErr.Quo(relErr, big.NewFloat(math.SmallestNonzeroFloat64))
+		if f, _ := relErr.Float64(); math.Abs(f) > 1e-15 {
+			t.Errorf("relative error vs math.SmallestNonzeroFloat64 = %g, want <= 1e-15", f)
 		}
 	})
 
 	t.Run("denormalized_big_endian", func(t *testing.T) {
-		// Create a denormalized number: exponent=0, mantissa != 0
+		// Smallest denormalized: 0x0000000000000001 = 2^-1074
 		bits := uint64(0x0000000000000001)
 		var buf bytes.Buffer
 		binary.Write(&buf, binary.BigEndian, bits)
@@ -587,10 +840,14 @@ func TestReadDoubleAsBigFloatSpecialCases(t *testing.T) {
 		if err != nil {
 			t.Fatalf("ReadDoubleAsBigFloat failed: %v", err)
 		}
-		// Currently denormalized numbers are handled as zero (see TODO in code)
-		if result.Sign() != 0 {
-			val, _ := result.Float64()
-			t.Errorf("ReadDoubleAsBigFloat(denormalized) = %v, want zero (denormalized handling TODO)", val)
+		want := new(big.Float).SetMantExp(big.NewFloat(1), -1074)
+		if result.Cmp(want) != 0 {
+			t.Errorf("ReadDoubleAsBigFloat(denormalized) = %s, want 2^-1074", result.Text('g', 20))
+		}
+		relErr := new(big.Float).Sub(result, big.NewFloat(math.SmallestNonzeroFloat64))
+		relErr.Quo(relErr, big.NewFloat(math.SmallestNonzeroFloat64))
+		if f, _ := relErr.Float64(); math.Abs(f) > 1e-15 {
+			t.Errorf("relative error vs math.SmallestNonzeroFloat64 = %g, want <= 1e-15", f)
 		}
 	})
 }
@@ -795,6 +1052,288 @@ func TestReadDoubleAsBigFloatAssemblyFunctions(t *testing.T) {
 	})
 }
 
+// TestReadBigVec6 tests reading state vectors in both byte orders
+func TestReadBigVec6(t *testing.T) {
+	prec := uint(256)
+	values := [6]float64{1.0000000000000002, -2.5e10, 3.14159e-7, 0.1, -0.2, 1.7976931348623157e308}
+
+	for _, bigEndian := range []bool{false, true} {
+		name := "little_endian"
+		order := binary.ByteOrder(binary.LittleEndian)
+		if bigEndian {
+			name = "big_endian"
+			order = binary.BigEndian
+		}
+
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			for _, v := range values {
+				binary.Write(&buf, order, v)
+			}
+
+			v, err := ReadBigVec6(&buf, bigEndian, prec)
+			if err != nil {
+				t.Fatalf("ReadBigVec6 failed: %v", err)
+			}
+
+			got := []*BigFloat{v.X, v.Y, v.Z, v.VX, v.VY, v.VZ}
+			for i, g := range got {
+				if g.Cmp(NewBigFloat(values[i], prec)) != 0 {
+					t.Errorf("component %d = %s, want %g", i, g.Text('g', 20), values[i])
+				}
+				if g.Prec() != prec {
+					t.Errorf("component %d precision = %d, want %d", i, g.Prec(), prec)
+				}
+			}
+		})
+	}
+
+	t.Run("short_read", func(t *testing.T) {
+		var buf bytes.Buffer
+		for _, v := range values[:5] {
+			binary.Write(&buf, binary.LittleEndian, v)
+		}
+		buf.Write([]byte{1, 2, 3})
+
+		if _, err := ReadBigVec6(&buf, false, prec); err == nil {
+			t.Error("ReadBigVec6 should fail with fewer than 48 bytes")
+		}
+	})
+}
+
+// TestReadBigVec3 tests reading 3D vectors in both byte orders
+func TestReadBigVec3(t *testing.T) {
+	prec := uint(128)
+	values := [3]float64{-1.5, 6.02214076e23, 4.0}
+
+	for _, bigEndian := range []bool{false, true} {
+		order := binary.ByteOrder(binary.LittleEndian)
+		if bigEndian {
+			order = binary.BigEndian
+		}
+
+		var buf bytes.Buffer
+		for _, v := range values {
+			binary.Write(&buf, order, v)
+		}
+
+		v, err := ReadBigVec3(&buf, bigEndian, prec)
+		if err != nil {
+			t.Fatalf("ReadBigVec3 failed: %v", err)
+		}
+		if got := v.ToFloat64(); got != values {
+			t.Errorf("ReadBigVec3(bigEndian=%v) = %v", bigEndian, got)
+		}
+	}
+
+	if _, err := ReadBigVec3(bytes.NewReader(make([]byte, 16)), false, prec); err == nil {
+		t.Error("ReadBigVec3 should fail with fewer than 24 bytes")
+	}
+}
+
+// TestReadDoublesAsBigFloat tests the batch reader against repeated single reads
+func TestReadDoublesAsBigFloat(t *testing.T) {
+	prec := uint(256)
+	const n = 1000
+
+	rng := rand.New(rand.NewSource(1))
+	bits := make([]uint64, n)
+	for i := range bits {
+		bits[i] = rng.Uint64()
+	}
+	// Make sure the special encodings are covered
+	bits[0] = 0                                     // +0
+	bits[1] = 1 << 63                               // -0
+	bits[2] = 1                                     // smallest subnormal
+	bits[3] = math.Float64bits(math.Inf(-1))        // -Inf
+	bits[4] = math.Float64bits(math.NaN())          // NaN
+	bits[5] = math.Float64bits(math.MaxFloat64)     // largest finite
+	bits[6] = math.Float64bits(-math.Pow(2, -1022)) // smallest normal
+	bits[7] = math.Float64bits(1.0 + 0x1p-52)       // 1 + ulp
+
+	for _, bigEndian := range []bool{false, true} {
+		t.Run(fmt.Sprintf("bigEndian_%v", bigEndian), func(t *testing.T) {
+			var buf bytes.Buffer
+			for _, b := range bits {
+				if bigEndian {
+					binary.Write(&buf, binary.BigEndian, b)
+				} else {
+					binary.Write(&buf, binary.LittleEndian, b)
+				}
+			}
+			data := buf.Bytes()
+
+			got, err := ReadDoublesAsBigFloat(bytes.NewReader(data), n, bigEndian, prec)
+			if err != nil {
+				t.Fatalf("ReadDoublesAsBigFloat failed: %v", err)
+			}
+			if len(got) != n {
+				t.Fatalf("ReadDoublesAsBigFloat returned %d values, want %d", len(got), n)
+			}
+
+			single := bytes.NewReader(data)
+			for i := 0; i < n; i++ {
+				want, err := ReadDoubleAsBigFloat(single, bigEndian, prec)
+				if err != nil {
+					t.Fatalf("ReadDoubleAsBigFloat failed at %d: %v", i, err)
+				}
+				if got[i].Cmp(want) != 0 || got[i].Signbit() != want.Signbit() || got[i].Prec() != prec {
+					t.Errorf("value %d (bits %#016x) = %s, want %s", i, bits[i], got[i].Text('g', 20), want.Text('g', 20))
+				}
+			}
+		})
+	}
+
+	t.Run("short_read", func(t *testing.T) {
+		data := make([]byte, 8*3+5)
+		binary.LittleEndian.PutUint64(data[16:], math.Float64bits(2.5))
+		got, err := ReadDoublesAsBigFloat(bytes.NewReader(data), 5, false, prec)
+		if err == nil {
+			t.Fatal("ReadDoublesAsBigFloat should fail on short read")
+		}
+		if !errors.Is(err, io.ErrUnexpectedEOF) || !strings.Contains(err.Error(), "decoded 3") {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if len(got) != 3 || !BigEqualFloat64(got[2], 2.5) {
+			t.Errorf("ReadDoublesAsBigFloat returned %d values on short read, want 3 ending in 2.5", len(got))
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got, err := ReadDoublesAsBigFloat(bytes.NewReader(nil), 0, false, prec)
+		if err != nil || len(got) != 0 {
+			t.Errorf("ReadDoublesAsBigFloat(n=0) = %v, %v; want empty and no error", got, err)
+		}
+		if _, err := ReadDoublesAsBigFloat(bytes.NewReader(nil), -1, false, prec); err == nil {
+			t.Error("ReadDoublesAsBigFloat(n=-1) should fail")
+		}
+	})
+}
+
+// TestReadFloat32AsBigFloat tests decoding of IEEE 754 single precision values
+func TestReadFloat32AsBigFloat(t *testing.T) {
+	prec := uint(256)
+
+	encode := func(f float32, bigEndian bool) *bytes.Reader {
+		var buf bytes.Buffer
+		if bigEndian {
+			binary.Write(&buf, binary.BigEndian, f)
+		} else {
+			binary.Write(&buf, binary.LittleEndian, f)
+		}
+		return bytes.NewReader(buf.Bytes())
+	}
+
+	tests := []struct {
+		name  string
+		value float32
+	}{
+		{"one", 1.0},
+		{"negative", -2.5},
+		{"pi", float32(math.Pi)},
+		{"max_float32", math.MaxFloat32},
+		{"smallest_normal", float32(math.Pow(2, -126))},
+		{"smallest_subnormal", math.SmallestNonzeroFloat32},
+		{"largest_subnormal", math.Float32frombits(0x007FFFFF)},
+		{"negative_subnormal", -math.Float32frombits(0x00012345)},
+	}
+
+	for _, tt := range tests {
+		for _, bigEndian := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s_bigEndian_%v", tt.name, bigEndian), func(t *testing.T) {
+				result, err := ReadFloat32AsBigFloat(encode(tt.value, bigEndian), bigEndian, prec)
+				if err != nil {
+					t.Fatalf("ReadFloat32AsBigFloat failed: %v", err)
+				}
+				// float32 -> float64 is exact, so the decoded value must match exactly
+				want := new(big.Float).SetFloat64(float64(tt.value))
+				if result.Cmp(want) != 0 || result.Prec() != prec {
+					t.Errorf("ReadFloat32AsBigFloat = %s (prec %d), want %s (prec %d)",
+						result.Text('g', 20), result.Prec(), want.Text('g', 20), prec)
+				}
+			})
+		}
+	}
+
+	t.Run("signed_zero", func(t *testing.T) {
+		pos, _ := ReadFloat32AsBigFloat(encode(0, false), false, prec)
+		neg, _ := ReadFloat32AsBigFloat(encode(float32(math.Copysign(0, -1)), false), false, prec)
+		if pos.Sign() != 0 || pos.Signbit() {
+			t.Errorf("ReadFloat32AsBigFloat(+0) = %s, want +0", pos.Text('g', 10))
+		}
+		if neg.Sign() != 0 || !neg.Signbit() {
+			t.Errorf("ReadFloat32AsBigFloat(-0) = %s, want -0", neg.Text('g', 10))
+		}
+	})
+
+	t.Run("infinity_and_nan", func(t *testing.T) {
+		posInf, _ := ReadFloat32AsBigFloat(encode(float32(math.Inf(1)), true), true, prec)
+		negInf, _ := ReadFloat32AsBigFloat(encode(float32(math.Inf(-1)), true), true, prec)
+		nan, _ := ReadFloat32AsBigFloat(encode(float32(math.NaN()), true), true, prec)
+		if !posInf.IsInf() || posInf.Sign() < 0 {
+			t.Errorf("ReadFloat32AsBigFloat(+Inf) = %s, want +Inf", posInf.Text('g', 10))
+		}
+		if !negInf.IsInf() || negInf.Sign() > 0 {
+			t.Errorf("ReadFloat32AsBigFloat(-Inf) = %s, want -Inf", negInf.Text('g', 10))
+		}
+		if nan.Sign() != 0 {
+			t.Errorf("ReadFloat32AsBigFloat(NaN) = %s, want zero sentinel", nan.Text('g', 10))
+		}
+	})
+
+	t.Run("precision_preservation", func(t *testing.T) {
+		// float32(0.1) is exactly 13421773 / 2^27
+		result, err := ReadFloat32AsBigFloat(bytes.NewReader([]byte{0x3D, 0xCC, 0xCC, 0xCD}), true, prec)
+		if err != nil {
+			t.Fatalf("ReadFloat32AsBigFloat failed: %v", err)
+		}
+		want := new(big.Float).SetMantExp(new(big.Float).SetInt64(13421773), -27)
+		if result.Cmp(want) != 0 {
+			t.Errorf("ReadFloat32AsBigFloat(0.1f) = %s, want %s", result.Text('g', 30), want.Text('g', 30))
+		}
+	})
+
+	t.Run("short_read", func(t *testing.T) {
+		result, err := ReadFloat32AsBigFloat(bytes.NewReader([]byte{0x01, 0x02}), false, prec)
+		if err == nil || result != nil {
+			t.Errorf("ReadFloat32AsBigFloat on short read = %v, %v; want nil and an error", result, err)
+		}
+	})
+}
+
+// TestDecodeDoubleBits checks the shared IEEE decoder used by the generic
+// ReadDoubleAsBigFloat path, the batch reader and the subnormal handlers
+func TestDecodeDoubleBits(t *testing.T) {
+	prec := uint(256)
+	values := []float64{
+		1, -2.5, math.Pi, math.MaxFloat64, -math.SmallestNonzeroFloat64,
+		math.Float64frombits(0x000FFFFFFFFFFFFF), math.Float64frombits(0x0010000000000000),
+		0, math.Copysign(0, -1), math.Inf(1), math.Inf(-1),
+	}
+
+	for _, v := range values {
+		z := new(BigFloat)
+		decodeDoubleBits(z, math.Float64bits(v), prec)
+		want := new(big.Float).SetFloat64(v)
+		if z.Cmp(want) != 0 || z.Signbit() != want.Signbit() || z.Prec() != prec {
+			t.Errorf("decodeDoubleBits(%g) = %s (prec %d), want %s", v, z.Text('g', 20), z.Prec(), want.Text('g', 20))
+		}
+
+		var buf bytes.Buffer
+		binary.Write(&buf, binary.LittleEndian, v)
+		got, err := readDoubleAsBigFloatGenericTest(&buf, false, prec)
+		if err != nil || got.Cmp(want) != 0 || got.Signbit() != want.Signbit() {
+			t.Errorf("generic ReadDoubleAsBigFloat(%g) = %v, %v; want %s", v, got, err, want.Text('g', 20))
+		}
+	}
+
+	nan := new(BigFloat)
+	decodeDoubleBits(nan, math.Float64bits(math.NaN()), prec)
+	if nan.Sign() != 0 || nan.IsInf() {
+		t.Errorf("decodeDoubleBits(NaN) = %s, want zero sentinel", nan.Text('g', 10))
+	}
+}
+
 // BenchmarkReadDoubleAsBigFloat benchmarks the ReadDoubleAsBigFloat function
 func BenchmarkReadDoubleAsBigFloat(b *testing.B) {
 	prec := uint(256)
@@ -818,6 +1357,39 @@ func BenchmarkReadDoubleAsBigFloat(b *testing.B) {
 	}
 }
 
+// BenchmarkReadDoublesAsBigFloat compares the batch reader with a loop of single reads
+func BenchmarkReadDoublesAsBigFloat(b *testing.B) {
+	prec := uint(256)
+	const n = 1000
+
+	var buf bytes.Buffer
+	for i := 0; i < n; i++ {
+		binary.Write(&buf, binary.LittleEndian, math.Pi*float64(i+1))
+	}
+	testData := buf.Bytes()
+
+	b.Run("batch", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ReadDoublesAsBigFloat(bytes.NewReader(testData), n, false, prec); err != nil {
+				b.Fatalf("ReadDoublesAsBigFloat failed: %v", err)
+			}
+		}
+	})
+
+	b.Run("single_loop", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			reader := bytes.NewReader(testData)
+			for j := 0; j < n; j++ {
+				if _, err := ReadDoubleAsBigFloat(reader, false, prec); err != nil {
+					b.Fatalf("ReadDoubleAsBigFloat failed: %v", err)
+				}
+			}
+		}
+	})
+}
+
 // BenchmarkReadDoubleAsBigFloatBigEndian benchmarks big-endian conversion
 func BenchmarkReadDoubleAsBigFloatBigEndian(b *testing.B) {
 	prec := uint(256)
@@ -901,74 +1473,8 @@ func readDoubleAsBigFloatGenericTest(r io.Reader, bigEndian bool, prec uint) (*B
 		bits = binary.LittleEndian.Uint64(doubleBytes)
 	}
 
-	// Extract IEEE 754 components
-	// Sign: bit 63
-	sign := (bits >> 63) != 0
-
-	// Exponent: bits 52-62 (11 bits)
-	exponent := int((bits >> 52) & 0x7FF)
-
-	// Mantissa: bits 0-51 (52 bits)
-	mantissa := bits & 0xFFFFFFFFFFFFF
-
-	// Handle special cases
-	if exponent == 0 {
-		if mantissa == 0 {
-			// Zero (positive or negative)
-			result := new(big.Float).SetPrec(prec)
-			if sign {
-				result.Neg(result)
-			}
-			return result, nil
-		}
-		// Denormalized number (subnormal)
-		result := new(big.Float).SetPrec(prec)
-		return result, nil
-	}
-
-	if exponent == 0x7FF {
-		// Infinity or NaN
-		if mantissa == 0 {
-			// Infinity
-			result := new(big.Float).SetPrec(prec)
-			result.SetInf(sign)
-			return result, nil
-		}
-		// NaN
-		result := new(big.Float).SetPrec(prec)
-		return result, nil
-	}
-
-	// Normalized number
-	// Construct mantissa as BigFloat: 1 + mantissa / 2^52
-	mantissaBig := new(big.Float).SetPrec(prec)
-	mantissaBig.SetUint64(mantissa)
-
-	// Divide by 2^52 to get fractional part
-	two52 := new(big.Float).SetPrec(prec)
-	two52.SetUint64(1 << 52) // 2^52
-	mantissaBig.Quo(mantissaBig, two52)
-
-	// Add 1 (implicit leading bit)
-	one := new(big.Float).SetPrec(prec)
-	one.SetUint64(1)
-	mantissaBig.Add(mantissaBig, one)
-
-	// Calculate exponent: 2^(exponent - 1023)
-	expValue := exponent - 1023
-
-	// Construct result: mantissa * 2^expValue
-	mant := new(big.Float).SetPrec(prec)
-	mantExp := mantissaBig.MantExp(mant)
-
-	result := new(big.Float).SetPrec(prec)
-	result.SetMantExp(mant, expValue+mantExp)
-
-	// Apply sign
-	if sign {
-		result.Neg(result)
-	}
-
+	result := new(BigFloat)
+	decodeDoubleBits(result, bits, prec)
 	return result, nil
 }
 
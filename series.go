@@ -0,0 +1,156 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"math"
+)
+
+// BigGeomSeries computes the finite geometric sum a + a*r + ... + a*r^(n-1)
+// using the closed form a(1-rⁿ)/(1-r), which avoids the accumulated rounding
+// of term-by-term summation. For r = 1 the sum is a*n. Returns 0 for n <= 0.
+func BigGeomSeries(a, r *BigFloat, n int, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = a.Prec()
+	}
+
+	if n <= 0 {
+		return NewBigFloat(0.0, prec)
+	}
+
+	workPrec := prec + 32
+	one := NewBigFloat(1.0, workPrec)
+
+	// r = 1: every term equals a
+	if r.Cmp(one) == 0 {
+		result := new(BigFloat).SetPrec(workPrec).Mul(a, NewBigFloat(float64(n), workPrec))
+		return new(BigFloat).SetPrec(prec).Set(result)
+	}
+
+	// a * (1 - rⁿ) / (1 - r)
+	rn := bigPowInteger(r, int64(n), workPrec)
+	num := new(BigFloat).SetPrec(workPrec).Sub(one, rn)
+	num.Mul(num, a)
+	den := new(BigFloat).SetPrec(workPrec).Sub(one, r)
+	result := new(BigFloat).SetPrec(workPrec).Quo(num, den)
+
+	return new(BigFloat).SetPrec(prec).Set(result)
+}
+
+// BigGeomSeriesInf computes the infinite geometric sum a + a*r + a*r² + ... = a/(1-r)
+// The series only converges for |r| < 1; otherwise NaN is returned
+// (which, as with NewBigFloat, is represented as zero)
+func BigGeomSeriesInf(a, r *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = a.Prec()
+	}
+
+	workPrec := prec + 32
+	one := NewBigFloat(1.0, workPrec)
+
+	if new(BigFloat).SetPrec(workPrec).Abs(r).Cmp(one) >= 0 {
+		return NewBigFloat(math.NaN(), prec)
+	}
+
+	den := new(BigFloat).SetPrec(workPrec).Sub(one, r)
+	result := new(BigFloat).SetPrec(workPrec).Quo(a, den)
+
+	return new(BigFloat).SetPrec(prec).Set(result)
+}
+
+// BigHarmonic computes the n-th harmonic number H_n = 1 + 1/2 + ... + 1/n
+// The terms are accumulated with compensated summation. Returns 0 for n <= 0.
+func BigHarmonic(n int, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+
+	h := newHarmonicSum(prec + 32)
+	for k := 1; k <= n; k++ {
+		h.next()
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(h.value())
+}
+
+// harmonicSum steps through the harmonic numbers H_0 = 0, H_1, H_2, ... with
+// compensated summation, so series that need every H_k cost one division per
+// term instead of recomputing each H_k from scratch
+type harmonicSum struct {
+	sum  *BigCompensatedSum
+	one  *BigFloat
+	k    int
+	prec uint
+}
+
+// newHarmonicSum starts the sequence at H_0 = 0
+func newHarmonicSum(prec uint) *harmonicSum {
+	return &harmonicSum{sum: NewBigCompensatedSum(prec), one: NewBigFloat(1.0, prec), prec: prec}
+}
+
+// next advances from H_k to H_{k+1} by adding 1/(k+1)
+func (h *harmonicSum) next() {
+	h.k++
+	h.sum.Add(new(BigFloat).SetPrec(h.prec).Quo(h.one, NewBigFloat(float64(h.k), h.prec)))
+}
+
+// value returns the current harmonic number H_k
+func (h *harmonicSum) value() *BigFloat {
+	return h.sum.Sum()
+}
+
+// BigHarmonicGen computes the generalized harmonic number
+// H_n^(s) = 1 + 1/2^s + ... + 1/n^s
+// The terms are accumulated with compensated summation. Returns 0 for n <= 0.
+func BigHarmonicGen(n int, s *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = s.Prec()
+	}
+
+	workPrec := prec + 32
+	sum := NewBigCompensatedSum(workPrec)
+	one := NewBigFloat(1.0, workPrec)
+	for k := 1; k <= n; k++ {
+		kPow := BigPow(NewBigFloat(float64(k), workPrec), s, workPrec)
+		sum.Add(new(BigFloat).SetPrec(workPrec).Quo(one, kPow))
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(sum.Sum())
+}
+
+// BigPolyEval evaluates the polynomial Σ coeffs[i]·x^i using Horner's scheme
+// coeffs[0] is the constant term. An empty coefficient slice evaluates to 0.
+func BigPolyEval(coeffs []*BigFloat, x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	workPrec := prec + 32
+	res := new(BigFloat).SetPrec(workPrec)
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		res.Mul(res, x)
+		res.Add(res, coeffs[i])
+	}
+	return new(BigFloat).SetPrec(prec).Set(res)
+}
+
+// BigPolyEvalDerivative evaluates the polynomial Σ coeffs[i]·x^i and its
+// derivative in a single Horner pass. An empty coefficient slice returns 0, 0.
+func BigPolyEvalDerivative(coeffs []*BigFloat, x *BigFloat, prec uint) (value, derivative *BigFloat) {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	workPrec := prec + 32
+	p := new(BigFloat).SetPrec(workPrec)
+	dp := new(BigFloat).SetPrec(workPrec)
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		// p' ← p'·x + p must use p before it absorbs coeffs[i]
+		dp.Mul(dp, x)
+		dp.Add(dp, p)
+		p.Mul(p, x)
+		p.Add(p, coeffs[i])
+	}
+	return new(BigFloat).SetPrec(prec).Set(p), new(BigFloat).SetPrec(prec).Set(dp)
+}
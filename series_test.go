@@ -0,0 +1,249 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBigGeomSeries(t *testing.T) {
+	prec := uint(256)
+
+	tests := []struct {
+		name     string
+		a, r     float64
+		n        int
+		expected float64
+	}{
+		{"powers_of_two", 1.0, 2.0, 4, 15.0},         // 1+2+4+8
+		{"halves", 1.0, 0.5, 4, 1.875},               // 1+1/2+1/4+1/8
+		{"ratio_one", 3.0, 1.0, 5, 15.0},             // a*n
+		{"negative_ratio", 1.0, -1.0, 5, 1.0},        // 1-1+1-1+1
+		{"scaled", 2.5, 3.0, 3, 32.5},                // 2.5+7.5+22.5
+		{"single_term", 7.0, 9.0, 1, 7.0},            // a
+		{"no_terms", 7.0, 9.0, 0, 0.0},               // empty sum
+		{"negative_count", 7.0, 9.0, -3, 0.0},        // empty sum
+		{"negative_a", -1.0, 10.0, 3, -111.0},        // -1-10-100
+		{"fraction_ratio", 8.0, 0.25, 3, 10.5},       // 8+2+0.5
+		{"ratio_one_negative_a", -0.5, 1.0, 4, -2.0}, // a*n
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := BigGeomSeries(NewBigFloat(tt.a, prec), NewBigFloat(tt.r, prec), tt.n, prec)
+			if result.Cmp(NewBigFloat(tt.expected, prec)) != 0 {
+				t.Errorf("BigGeomSeries(%g, %g, %d) = %s, want %g", tt.a, tt.r, tt.n, result.Text('g', 30), tt.expected)
+			}
+			if result.Prec() != prec {
+				t.Errorf("precision = %d, want %d", result.Prec(), prec)
+			}
+		})
+	}
+
+	t.Run("large_n", func(t *testing.T) {
+		// 1 + 2 + ... + 2^99 = 2^100 - 1, which is not representable in float64
+		result := BigGeomSeries(NewBigFloat(1.0, prec), NewBigFloat(2.0, prec), 100, prec)
+		want := new(BigFloat).SetPrec(prec).SetMantExp(NewBigFloat(1.0, prec), 100)
+		want.Sub(want, NewBigFloat(1.0, prec))
+		if result.Cmp(want) != 0 {
+			t.Errorf("BigGeomSeries(1, 2, 100) = %s, want %s", result.Text('f', 0), want.Text('f', 0))
+		}
+	})
+}
+
+func TestBigGeomSeriesInf(t *testing.T) {
+	prec := uint(256)
+
+	tests := []struct {
+		name     string
+		a, r     float64
+		expected float64
+	}{
+		{"halves", 1.0, 0.5, 2.0},
+		{"negative_ratio", 1.0, -0.5, 2.0 / 3.0},
+		{"scaled_quarter", 3.0, 0.25, 4.0},
+		{"zero_ratio", 5.0, 0.0, 5.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := BigGeomSeriesInf(NewBigFloat(tt.a, prec), NewBigFloat(tt.r, prec), prec)
+			want := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(tt.a, prec),
+				new(BigFloat).SetPrec(prec).Sub(NewBigFloat(1.0, prec), NewBigFloat(tt.r, prec)))
+			if result.Cmp(want) != 0 {
+				t.Errorf("BigGeomSeriesInf(%g, %g) = %s, want %s", tt.a, tt.r, result.Text('g', 30), want.Text('g', 30))
+			}
+		})
+	}
+
+	// Exactly 2 for 1 + 1/2 + 1/4 + ...
+	if !BigEqualFloat64(BigGeomSeriesInf(NewBigFloat(1.0, prec), NewBigFloat(0.5, prec), prec), 2.0) {
+		t.Error("BigGeomSeriesInf(1, 0.5) != 2 exactly")
+	}
+
+	// Divergent series return the NaN sentinel
+	nan := NewBigFloat(math.NaN(), prec)
+	for _, r := range []float64{1.0, -1.0, 2.0, -3.5} {
+		result := BigGeomSeriesInf(NewBigFloat(1.0, prec), NewBigFloat(r, prec), prec)
+		if result.Cmp(nan) != 0 || result.IsInf() {
+			t.Errorf("BigGeomSeriesInf(1, %g) = %s, want NaN sentinel", r, result.Text('g', 10))
+		}
+	}
+}
+
+func TestBigHarmonic(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-60", prec)
+
+	exact := []struct {
+		n        int
+		num, den float64
+	}{
+		{0, 0, 1},
+		{1, 1, 1},
+		{2, 3, 2},
+		{4, 25, 12},
+		{10, 7381, 2520},
+	}
+
+	for _, tt := range exact {
+		want := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(tt.num, prec), NewBigFloat(tt.den, prec))
+		diff := new(BigFloat).SetPrec(prec).Sub(BigHarmonic(tt.n, prec), want)
+		if diff.Abs(diff).Cmp(tolerance) > 0 {
+			t.Errorf("BigHarmonic(%d) differs from %g/%g by %s", tt.n, tt.num, tt.den, diff.Text('g', 10))
+		}
+	}
+
+	// H_n - ln(n) - γ = 1/(2n) - O(1/n²)
+	t.Run("asymptotic", func(t *testing.T) {
+		n := 1000
+		nf := NewBigFloat(float64(n), prec)
+		diff := new(BigFloat).SetPrec(prec).Sub(BigHarmonic(n, prec), BigLog(nf, prec))
+		diff.Sub(diff, BigEulerGamma(prec))
+		got, _ := diff.Float64()
+		if got <= 0 || got >= 1.0/float64(n) {
+			t.Errorf("H_%d - ln(%d) - γ = %g, want in (0, 1/n)", n, n, got)
+		}
+		if math.Abs(got-0.5/float64(n)) > 1.0/(12*float64(n)*float64(n))+1e-12 {
+			t.Errorf("H_%d - ln(%d) - γ = %g, want ≈ 1/(2n) = %g", n, n, got, 0.5/float64(n))
+		}
+	})
+
+	// The running sum used by the Bessel Y series steps through the same values
+	t.Run("running_sum", func(t *testing.T) {
+		h := newHarmonicSum(prec + 32)
+		for k := 0; k <= 50; k++ {
+			if k > 0 {
+				h.next()
+			}
+			got := new(BigFloat).SetPrec(prec).Set(h.value())
+			if got.Cmp(BigHarmonic(k, prec)) != 0 {
+				t.Errorf("running H_%d = %s, want BigHarmonic(%d)", k, got.Text('g', 30), k)
+			}
+		}
+	})
+}
+
+func TestBigHarmonicGen(t *testing.T) {
+	prec := uint(256)
+	two := NewBigFloat(2.0, prec)
+
+	// H_4^(2) = 1 + 1/4 + 1/9 + 1/16 = 205/144
+	want := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(205, prec), NewBigFloat(144, prec))
+	tolerance, _ := NewBigFloatFromString("1e-60", prec)
+	diff := new(BigFloat).SetPrec(prec).Sub(BigHarmonicGen(4, two, prec), want)
+	if diff.Abs(diff).Cmp(tolerance) > 0 {
+		t.Errorf("BigHarmonicGen(4, 2) differs from 205/144 by %s", diff.Text('g', 10))
+	}
+
+	// s = 1 matches the ordinary harmonic number
+	diff.Sub(BigHarmonicGen(50, NewBigFloat(1.0, prec), prec), BigHarmonic(50, prec))
+	if diff.Abs(diff).Cmp(tolerance) > 0 {
+		t.Errorf("BigHarmonicGen(50, 1) differs from BigHarmonic(50) by %s", diff.Text('g', 10))
+	}
+
+	// H_n^(2) → π²/6 with a tail between 1/(n+1) and 1/n
+	zeta2 := new(BigFloat).SetPrec(prec).Mul(BigPI(prec), BigPI(prec))
+	zeta2.Quo(zeta2, NewBigFloat(6.0, prec))
+	prevGap := math.Inf(1)
+	for _, n := range []int{10, 100, 1000} {
+		gap := new(BigFloat).SetPrec(prec).Sub(zeta2, BigHarmonicGen(n, two, prec))
+		got, _ := gap.Float64()
+		if got <= 1.0/float64(n+1) || got >= 1.0/float64(n) {
+			t.Errorf("π²/6 - H_%d^(2) = %g, want in (1/(n+1), 1/n)", n, got)
+		}
+		if got >= prevGap {
+			t.Errorf("π²/6 - H_%d^(2) = %g did not shrink", n, got)
+		}
+		prevGap = got
+	}
+}
+
+func TestBigPolyEval(t *testing.T) {
+	prec := uint(256)
+
+	// 7 + 0.5x - 3x² + 2x³
+	coeffs := []*BigFloat{NewBigFloat(7, prec), NewBigFloat(0.5, prec), NewBigFloat(-3, prec), NewBigFloat(2, prec)}
+
+	tests := []struct {
+		name       string
+		x          float64
+		value      float64
+		derivative float64
+	}{
+		{"zero", 0, 7, 0.5},
+		{"one", 1, 6.5, 0.5},
+		{"one_and_half", 1.5, 7.75, 5},
+		{"negative", -2, -22, 36.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x := NewBigFloat(tt.x, prec)
+			if got := BigPolyEval(coeffs, x, prec); got.Cmp(NewBigFloat(tt.value, prec)) != 0 {
+				t.Errorf("BigPolyEval(%g) = %s, want %g", tt.x, got.Text('g', 20), tt.value)
+			}
+			v, d := BigPolyEvalDerivative(coeffs, x, prec)
+			if v.Cmp(NewBigFloat(tt.value, prec)) != 0 || d.Cmp(NewBigFloat(tt.derivative, prec)) != 0 {
+				t.Errorf("BigPolyEvalDerivative(%g) = (%s, %s), want (%g, %g)", tt.x, v.Text('g', 20), d.Text('g', 20), tt.value, tt.derivative)
+			}
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		x := NewBigFloat(3, prec)
+		if got := BigPolyEval(nil, x, prec); got.Sign() != 0 {
+			t.Errorf("BigPolyEval(empty) = %s, want 0", got.Text('g', 10))
+		}
+		if v, d := BigPolyEvalDerivative(nil, x, prec); v.Sign() != 0 || d.Sign() != 0 {
+			t.Errorf("BigPolyEvalDerivative(empty) = (%s, %s), want (0, 0)", v.Text('g', 10), d.Text('g', 10))
+		}
+	})
+
+	t.Run("derivative_matches_finite_difference", func(t *testing.T) {
+		tol, _ := NewBigFloatFromString("1e-25", prec)
+		h, _ := NewBigFloatFromString("1e-15", prec)
+		poly := make([]*BigFloat, 8)
+		for i := range poly {
+			poly[i] = NewBigFloat(math.Sin(float64(i+1))*3, prec)
+		}
+
+		for _, xf := range []float64{-1.3, 0.2, 0.9, 2.5} {
+			x := NewBigFloat(xf, prec)
+			_, d := BigPolyEvalDerivative(poly, x, prec)
+
+			// Central difference (p(x+h) - p(x-h)) / 2h has O(h²) error
+			fPlus := BigPolyEval(poly, new(BigFloat).SetPrec(prec).Add(x, h), prec)
+			fMinus := BigPolyEval(poly, new(BigFloat).SetPrec(prec).Sub(x, h), prec)
+			fd := new(BigFloat).SetPrec(prec).Sub(fPlus, fMinus)
+			fd.Quo(fd, new(BigFloat).SetPrec(prec).Add(h, h))
+
+			diff := new(BigFloat).SetPrec(prec).Sub(d, fd)
+			if diff.Abs(diff).Cmp(tol) > 0 {
+				t.Errorf("x=%g: derivative = %s, finite difference = %s", xf, d.Text('g', 40), fd.Text('g', 40))
+			}
+		}
+	})
+}
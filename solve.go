@@ -0,0 +1,294 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// solverMaxIterations bounds the number of iterations of the root finders
+const solverMaxIterations = 200
+
+// solverConverged reports whether step is small enough relative to x
+// |step| <= 2^-prec * max(1, |x|)
+func solverConverged(step, x *BigFloat, prec uint) bool {
+	if step.Sign() == 0 {
+		return true
+	}
+	scale := 0
+	if x.Sign() != 0 && x.MantExp(nil) > 0 {
+		scale = x.MantExp(nil)
+	}
+	return step.MantExp(nil) <= scale-int(prec)
+}
+
+// BigSecantSolve finds a root of f using the secant method started from the
+// two initial guesses x0 and x1. No bracket or derivative is needed, but
+// convergence is only local. Iteration stops once the step falls below
+// 2^-prec relative to the iterate. Returns an error if the secant becomes
+// horizontal, the iterate overflows, or the method does not converge.
+func BigSecantSolve(f func(*BigFloat) *BigFloat, x0, x1 *BigFloat, prec uint) (*BigFloat, error) {
+	if prec == 0 {
+		prec = x0.Prec()
+	}
+
+	workPrec := prec + 32
+	a := new(BigFloat).SetPrec(workPrec).Set(x0)
+	b := new(BigFloat).SetPrec(workPrec).Set(x1)
+	fa := f(a)
+	fb := f(b)
+
+	for i := 0; i < solverMaxIterations; i++ {
+		if fb.Sign() == 0 {
+			return new(BigFloat).SetPrec(prec).Set(b), nil
+		}
+
+		denom := new(BigFloat).SetPrec(workPrec).Sub(fb, fa)
+		if denom.Sign() == 0 {
+			return nil, errors.New("secant step is undefined (f(x0) == f(x1))")
+		}
+
+		// step = f(b) * (b - a) / (f(b) - f(a))
+		step := new(BigFloat).SetPrec(workPrec).Sub(b, a)
+		step.Mul(step, fb)
+		step.Quo(step, denom)
+
+		next := new(BigFloat).SetPrec(workPrec).Sub(b, step)
+		if next.IsInf() {
+			return nil, errors.New("secant method diverged")
+		}
+		if solverConverged(step, next, prec) {
+			return new(BigFloat).SetPrec(prec).Set(next), nil
+		}
+
+		a, fa = b, fb
+		b, fb = next, f(next)
+	}
+
+	return nil, fmt.Errorf("secant method did not converge after %d iterations", solverMaxIterations)
+}
+
+// BigNewtonSolve finds a root of f by Newton iteration x ← x - f(x)/f'(x)
+// starting from x0, with df supplying the analytic derivative. Convergence is
+// quadratic near a simple root. Returns an error if |f'(x)| drops below
+// 2^-prec, the iterate overflows, or the method does not converge.
+func BigNewtonSolve(f, df func(*BigFloat) *BigFloat, x0 *BigFloat, prec uint) (*BigFloat, error) {
+	if prec == 0 {
+		prec = x0.Prec()
+	}
+
+	workPrec := prec + 32
+	x := new(BigFloat).SetPrec(workPrec).Set(x0)
+
+	for i := 0; i < solverMaxIterations; i++ {
+		fx := f(x)
+		if fx.Sign() == 0 {
+			return new(BigFloat).SetPrec(prec).Set(x), nil
+		}
+
+		dfx := df(x)
+		if dfx.Sign() == 0 || dfx.MantExp(nil) < -int(prec) {
+			return nil, fmt.Errorf("derivative is too close to zero at x = %s", x.Text('g', 20))
+		}
+
+		step := new(BigFloat).SetPrec(workPrec).Quo(fx, dfx)
+		x = new(BigFloat).SetPrec(workPrec).Sub(x, step)
+		if x.IsInf() {
+			return nil, errors.New("newton iteration diverged")
+		}
+		if solverConverged(step, x, prec) {
+			return new(BigFloat).SetPrec(prec).Set(x), nil
+		}
+	}
+
+	return nil, fmt.Errorf("newton iteration did not converge after %d iterations", solverMaxIterations)
+}
+
+// BigNewtonRoot finds a root of f by Newton iteration x ← x - f(x)/f'(x)
+// starting from x0, stopping as soon as |f(x)| < tol. Unlike BigNewtonSolve,
+// the caller chooses the residual tolerance and the iteration budget. Returns
+// an error if maxIter is negative, f'(x) is zero, the iterate overflows, or
+// |f(x)| is still at least tol after maxIter steps.
+func BigNewtonRoot(f, df func(*BigFloat) *BigFloat, x0 *BigFloat, tol *BigFloat, maxIter int, prec uint) (*BigFloat, error) {
+	return BigNewtonRootCtx(context.Background(), f, df, x0, tol, maxIter, prec)
+}
+
+// BigNewtonRootCtx is BigNewtonRoot with cancellation: ctx is checked before
+// every iteration, and its error is returned wrapped once it is done
+func BigNewtonRootCtx(ctx context.Context, f, df func(*BigFloat) *BigFloat, x0 *BigFloat, tol *BigFloat, maxIter int, prec uint) (*BigFloat, error) {
+	if maxIter < 0 {
+		return nil, fmt.Errorf("iteration limit must be non-negative, got %d", maxIter)
+	}
+	if prec == 0 {
+		prec = x0.Prec()
+	}
+
+	workPrec := prec + 32
+	x := new(BigFloat).SetPrec(workPrec).Set(x0)
+	absFx := new(BigFloat).SetPrec(workPrec)
+
+	for i := 0; ; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("newton iteration stopped after %d iterations: %w", i, err)
+		}
+
+		fx := f(x)
+		if absFx.Abs(fx).Cmp(tol) < 0 {
+			return new(BigFloat).SetPrec(prec).Set(x), nil
+		}
+		if i == maxIter {
+			break
+		}
+
+		dfx := df(x)
+		if dfx.Sign() == 0 {
+			return nil, fmt.Errorf("derivative is zero at x = %s", x.Text('g', 20))
+		}
+
+		step := new(BigFloat).SetPrec(workPrec).Quo(fx, dfx)
+		x = new(BigFloat).SetPrec(workPrec).Sub(x, step)
+		if x.IsInf() {
+			return nil, errors.New("newton iteration diverged")
+		}
+	}
+
+	return nil, fmt.Errorf("newton iteration did not reach |f(x)| < %s after %d iterations", tol.Text('g', 10), maxIter)
+}
+
+// BigBrentRoot finds a root of f in the bracket [a, b] with Brent's method,
+// which takes inverse quadratic interpolation or secant steps when they make
+// progress and falls back to bisection otherwise. The root is returned once it
+// is known to within tol (or 2^-prec relative to the iterate). f(a) and f(b)
+// must have opposite signs; otherwise an error is returned.
+func BigBrentRoot(f func(*BigFloat) *BigFloat, a, b *BigFloat, tol *BigFloat, prec uint) (*BigFloat, error) {
+	return BigBrentRootCtx(context.Background(), f, a, b, tol, prec)
+}
+
+// BigBrentRootCtx is BigBrentRoot with cancellation: ctx is checked before
+// every evaluation of f, and its error is returned wrapped once it is done
+func BigBrentRootCtx(ctx context.Context, f func(*BigFloat) *BigFloat, a, b *BigFloat, tol *BigFloat, prec uint) (*BigFloat, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("brent's method stopped before starting: %w", err)
+	}
+	if prec == 0 {
+		prec = a.Prec()
+	}
+
+	workPrec := prec + 32
+	newF := func() *BigFloat { return new(BigFloat).SetPrec(workPrec) }
+	half := NewBigFloat(0.5, workPrec)
+	one := NewBigFloat(1.0, workPrec)
+
+	a = newF().Set(a)
+	b = newF().Set(b)
+	fa, fb := f(a), f(b)
+	if fa.Sign() == 0 {
+		return new(BigFloat).SetPrec(prec).Set(a), nil
+	}
+	if fb.Sign() == 0 {
+		return new(BigFloat).SetPrec(prec).Set(b), nil
+	}
+	if fa.Sign() == fb.Sign() {
+		return nil, fmt.Errorf("root is not bracketed: f(a) = %s and f(b) = %s have the same sign", fa.Text('g', 10), fb.Text('g', 10))
+	}
+
+	// b is the best estimate, a the previous one, and c the contrapoint with
+	// f(c) of opposite sign to f(b); d is the last step and e the one before
+	c, fc := b, fb
+	var d, e *BigFloat
+
+	// Pure bisection would need about workPrec halvings on a unit bracket
+	maxIter := solverMaxIterations + int(workPrec)
+	for i := 0; i < maxIter; i++ {
+		if fb.Sign() == fc.Sign() {
+			c, fc = a, fa
+			d = newF().Sub(b, a)
+			e = d
+		}
+		if newF().Abs(fc).Cmp(newF().Abs(fb)) < 0 {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		// tol1 = 2^-prec·|b| + tol/2, xm = (c - b)/2
+		tol1 := newF().Abs(b)
+		tol1.SetMantExp(tol1, -int(prec))
+		tol1.Add(tol1, newF().Mul(tol, half))
+		xm := newF().Sub(c, b)
+		xm.Mul(xm, half)
+		if fb.Sign() == 0 || newF().Abs(xm).Cmp(tol1) <= 0 {
+			return new(BigFloat).SetPrec(prec).Set(b), nil
+		}
+
+		if newF().Abs(e).Cmp(tol1) >= 0 && newF().Abs(fa).Cmp(newF().Abs(fb)) > 0 {
+			var p, q *BigFloat
+			s := newF().Quo(fb, fa)
+			if a.Cmp(c) == 0 {
+				// Secant step: p = 2·xm·s, q = 1 - s
+				p = newF().Mul(xm, s)
+				p.SetMantExp(p, 1)
+				q = newF().Sub(one, s)
+			} else {
+				// Inverse quadratic interpolation through a, b and c:
+				// p = s·(2·xm·q·(q - r) - (b - a)·(r - 1))
+				// q = (q - 1)·(r - 1)·(s - 1)
+				q = newF().Quo(fa, fc)
+				r := newF().Quo(fb, fc)
+				t1 := newF().Sub(q, r)
+				t1.Mul(t1, q)
+				t1.Mul(t1, xm)
+				t1.SetMantExp(t1, 1)
+				t2 := newF().Sub(b, a)
+				t2.Mul(t2, newF().Sub(r, one))
+				p = newF().Sub(t1, t2)
+				p.Mul(p, s)
+				q.Sub(q, one)
+				q.Mul(q, newF().Sub(r, one))
+				q.Mul(q, newF().Sub(s, one))
+			}
+			if p.Sign() > 0 {
+				q.Neg(q)
+			}
+			p.Abs(p)
+
+			// Accept the interpolation only if it stays inside the bracket
+			// and shrinks faster than the step before last
+			min1 := newF().Mul(xm, q)
+			min1.Mul(min1, NewBigFloat(3.0, workPrec))
+			min1.Sub(min1, newF().Abs(newF().Mul(tol1, q)))
+			min2 := newF().Abs(newF().Mul(e, q))
+			if min2.Cmp(min1) < 0 {
+				min1 = min2
+			}
+			if newF().SetMantExp(p, 1).Cmp(min1) < 0 {
+				e = d
+				d = newF().Quo(p, q)
+			} else {
+				d = xm
+				e = d
+			}
+		} else {
+			d = xm
+			e = d
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("brent's method stopped after %d iterations: %w", i, err)
+		}
+
+		a, fa = b, fb
+		if newF().Abs(d).Cmp(tol1) > 0 {
+			b = newF().Add(b, d)
+		} else if xm.Sign() > 0 {
+			b = newF().Add(b, tol1)
+		} else {
+			b = newF().Sub(b, tol1)
+		}
+		fb = f(b)
+	}
+
+	return nil, fmt.Errorf("brent's method did not converge after %d iterations", maxIter)
+}
@@ -0,0 +1,396 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBigSecantSolve(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-40", prec)
+
+	t.Run("dottie_number", func(t *testing.T) {
+		// cos(x) - x = 0 near 0.739085
+		f := func(x *BigFloat) *BigFloat {
+			return new(BigFloat).SetPrec(x.Prec()).Sub(BigCos(x, x.Prec()), x)
+		}
+		root, err := BigSecantSolve(f, NewBigFloat(0.5, prec), NewBigFloat(1.0, prec), prec)
+		if err != nil {
+			t.Fatalf("BigSecantSolve returned error: %v", err)
+		}
+		residual := f(root)
+		if residual.Abs(residual).Cmp(tolerance) > 0 {
+			t.Errorf("cos(x) - x = %s at x = %s, want within 1e-40", residual.Text('g', 10), root.Text('g', 30))
+		}
+		want, _ := NewBigFloatFromString("0.7390851332151606416553120876738734040134", prec)
+		diff := new(BigFloat).SetPrec(prec).Sub(root, want)
+		if diff.Abs(diff).Cmp(tolerance) > 0 {
+			t.Errorf("root = %s, want %s", root.Text('g', 45), want.Text('g', 45))
+		}
+	})
+
+	t.Run("sqrt2", func(t *testing.T) {
+		f := func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+			return r.Sub(r, NewBigFloat(2.0, x.Prec()))
+		}
+		root, err := BigSecantSolve(f, NewBigFloat(1.0, prec), NewBigFloat(2.0, prec), prec)
+		if err != nil {
+			t.Fatalf("BigSecantSolve returned error: %v", err)
+		}
+		diff := new(BigFloat).SetPrec(prec).Sub(root, BigSqrt(NewBigFloat(2.0, prec), prec))
+		if diff.Abs(diff).Cmp(tolerance) > 0 {
+			t.Errorf("root = %s, want √2", root.Text('g', 45))
+		}
+	})
+
+	t.Run("no_root_returns_error", func(t *testing.T) {
+		// x² + 1 has no real root; the iteration must stop with an error
+		f := func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+			return r.Add(r, NewBigFloat(1.0, x.Prec()))
+		}
+		if root, err := BigSecantSolve(f, NewBigFloat(0.0, prec), NewBigFloat(1.0, prec), prec); err == nil {
+			t.Errorf("BigSecantSolve returned %s, want error", root.Text('g', 20))
+		}
+	})
+
+	t.Run("horizontal_secant_returns_error", func(t *testing.T) {
+		f := func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+			return r.Add(r, NewBigFloat(1.0, x.Prec()))
+		}
+		if _, err := BigSecantSolve(f, NewBigFloat(-1.0, prec), NewBigFloat(1.0, prec), prec); err == nil {
+			t.Error("BigSecantSolve with f(x0) == f(x1) returned nil error")
+		}
+	})
+}
+
+func TestBigNewtonSolve(t *testing.T) {
+	prec := uint(256)
+
+	t.Run("sqrt2", func(t *testing.T) {
+		calls := 0
+		f := func(x *BigFloat) *BigFloat {
+			calls++
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+			return r.Sub(r, NewBigFloat(2.0, x.Prec()))
+		}
+		df := func(x *BigFloat) *BigFloat {
+			return new(BigFloat).SetPrec(x.Prec()).Mul(x, NewBigFloat(2.0, x.Prec()))
+		}
+		root, err := BigNewtonSolve(f, df, NewBigFloat(1.0, prec), prec)
+		if err != nil {
+			t.Fatalf("BigNewtonSolve returned error: %v", err)
+		}
+		tolerance, _ := NewBigFloatFromString("1e-60", prec)
+		diff := new(BigFloat).SetPrec(prec).Sub(root, BigSqrt(NewBigFloat(2.0, prec), prec))
+		if diff.Abs(diff).Cmp(tolerance) > 0 {
+			t.Errorf("root = %s, want √2 within 1e-60", root.Text('g', 70))
+		}
+		// Quadratic convergence: 256 bits from x0 = 1 in about 9 steps
+		if calls > 12 {
+			t.Errorf("BigNewtonSolve took %d iterations, want at most 12", calls)
+		}
+	})
+
+	t.Run("dottie_number", func(t *testing.T) {
+		f := func(x *BigFloat) *BigFloat {
+			return new(BigFloat).SetPrec(x.Prec()).Sub(BigCos(x, x.Prec()), x)
+		}
+		// d/dx (cos(x) - x) = -sin(x) - 1
+		df := func(x *BigFloat) *BigFloat {
+			r := BigSin(x, x.Prec())
+			r.Neg(r)
+			return r.Sub(r, NewBigFloat(1.0, x.Prec()))
+		}
+		root, err := BigNewtonSolve(f, df, NewBigFloat(1.0, prec), prec)
+		if err != nil {
+			t.Fatalf("BigNewtonSolve returned error: %v", err)
+		}
+		tolerance, _ := NewBigFloatFromString("1e-60", prec)
+		residual := f(root)
+		if residual.Abs(residual).Cmp(tolerance) > 0 {
+			t.Errorf("cos(x) - x = %s at x = %s, want within 1e-60", residual.Text('g', 10), root.Text('g', 30))
+		}
+	})
+
+	t.Run("flat_derivative_returns_error", func(t *testing.T) {
+		// (x - 1)² + 1e-100 has a near-double root at 1 where f'(1) = 0
+		eps, _ := NewBigFloatFromString("1e-100", prec)
+		f := func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Sub(x, NewBigFloat(1.0, x.Prec()))
+			r.Mul(r, r)
+			return r.Add(r, eps)
+		}
+		df := func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Sub(x, NewBigFloat(1.0, x.Prec()))
+			return r.Mul(r, NewBigFloat(2.0, x.Prec()))
+		}
+		if root, err := BigNewtonSolve(f, df, NewBigFloat(1.0, prec), prec); err == nil {
+			t.Errorf("BigNewtonSolve returned %s, want error", root.Text('g', 20))
+		}
+	})
+}
+
+func TestBigNewtonRoot(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	t.Run("sqrt2", func(t *testing.T) {
+		f := func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+			return r.Sub(r, NewBigFloat(2.0, x.Prec()))
+		}
+		df := func(x *BigFloat) *BigFloat {
+			return new(BigFloat).SetPrec(x.Prec()).Mul(x, NewBigFloat(2.0, x.Prec()))
+		}
+		root, err := BigNewtonRoot(f, df, NewBigFloat(1.0, prec), tol, 20, prec)
+		if err != nil {
+			t.Fatalf("BigNewtonRoot returned error: %v", err)
+		}
+		want, _ := NewBigFloatFromString("1e-50", prec)
+		diff := new(BigFloat).SetPrec(prec).Sub(root, BigSqrt(NewBigFloat(2.0, prec), prec))
+		if diff.Abs(diff).Cmp(want) > 0 {
+			t.Errorf("root = %s, want √2 within 1e-50", root.Text('g', 60))
+		}
+	})
+
+	// Kepler's equation M = E - e·sin(E) for the eccentric anomaly E
+	kepler := func(m, e *BigFloat) (f, df func(*BigFloat) *BigFloat) {
+		f = func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(e, BigSin(x, x.Prec()))
+			r.Sub(x, r)
+			return r.Sub(r, m)
+		}
+		df = func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(e, BigCos(x, x.Prec()))
+			return r.Sub(NewBigFloat(1.0, x.Prec()), r)
+		}
+		return f, df
+	}
+
+	keplerTests := []struct {
+		name  string
+		m, e  string
+		seed  *BigFloat
+		known string
+	}{
+		{"moderate_eccentricity", "1", "0.3", NewBigFloat(1.0, prec), ""},
+		// The standard seed E0 = π converges for any eccentricity below 1
+		{"high_eccentricity", "0.1", "0.99", BigPI(prec), ""},
+		{"circular_orbit", "0.75", "0", NewBigFloat(0.0, prec), "0.75"},
+	}
+
+	for _, tt := range keplerTests {
+		t.Run("kepler_"+tt.name, func(t *testing.T) {
+			m, _ := NewBigFloatFromString(tt.m, prec)
+			e, _ := NewBigFloatFromString(tt.e, prec)
+			f, df := kepler(m, e)
+			root, err := BigNewtonRoot(f, df, tt.seed, tol, 50, prec)
+			if err != nil {
+				t.Fatalf("BigNewtonRoot returned error: %v", err)
+			}
+			residual := f(root)
+			if residual.Abs(residual).Cmp(tol) > 0 {
+				t.Errorf("E - e·sin(E) - M = %s at E = %s, want within 1e-60", residual.Text('g', 10), root.Text('g', 40))
+			}
+			if tt.known != "" {
+				want, _ := NewBigFloatFromString(tt.known, prec)
+				if !BigFloatEqual(root, want, tol) {
+					t.Errorf("E = %s, want %s", root.Text('g', 40), tt.known)
+				}
+			}
+		})
+	}
+
+	t.Run("zero_derivative_returns_error", func(t *testing.T) {
+		// x² + 1 has f'(0) = 0 at the starting point
+		f := func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+			return r.Add(r, NewBigFloat(1.0, x.Prec()))
+		}
+		df := func(x *BigFloat) *BigFloat {
+			return new(BigFloat).SetPrec(x.Prec()).Mul(x, NewBigFloat(2.0, x.Prec()))
+		}
+		if root, err := BigNewtonRoot(f, df, NewBigFloat(0.0, prec), tol, 50, prec); err == nil {
+			t.Errorf("BigNewtonRoot returned %s, want error", root.Text('g', 20))
+		}
+	})
+
+	t.Run("iteration_limit_returns_error", func(t *testing.T) {
+		f := func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+			return r.Sub(r, NewBigFloat(2.0, x.Prec()))
+		}
+		df := func(x *BigFloat) *BigFloat {
+			return new(BigFloat).SetPrec(x.Prec()).Mul(x, NewBigFloat(2.0, x.Prec()))
+		}
+		if root, err := BigNewtonRoot(f, df, NewBigFloat(100.0, prec), tol, 3, prec); err == nil {
+			t.Errorf("BigNewtonRoot returned %s after 3 iterations, want error", root.Text('g', 20))
+		}
+	})
+
+	t.Run("negative_iteration_limit_returns_error", func(t *testing.T) {
+		// x² + 1 has no real root, so only the limit check can stop the loop
+		f := func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+			return r.Add(r, NewBigFloat(1.0, x.Prec()))
+		}
+		df := func(x *BigFloat) *BigFloat {
+			return new(BigFloat).SetPrec(x.Prec()).Mul(x, NewBigFloat(2.0, x.Prec()))
+		}
+		if root, err := BigNewtonRoot(f, df, NewBigFloat(0.5, prec), tol, -1, prec); err == nil {
+			t.Errorf("BigNewtonRoot with maxIter = -1 returned %s, want error", root.Text('g', 20))
+		}
+	})
+}
+
+func TestBigBrentRoot(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+
+	t.Run("dottie_number", func(t *testing.T) {
+		calls := 0
+		f := func(x *BigFloat) *BigFloat {
+			calls++
+			return new(BigFloat).SetPrec(x.Prec()).Sub(BigCos(x, x.Prec()), x)
+		}
+		root, err := BigBrentRoot(f, NewBigFloat(0.0, prec), NewBigFloat(1.0, prec), tol, prec)
+		if err != nil {
+			t.Fatalf("BigBrentRoot returned error: %v", err)
+		}
+		want, _ := NewBigFloatFromString("0.7390851332151606416553120876738734040134", prec)
+		if !BigFloatEqual(root, want, tol) {
+			t.Errorf("root = %s, want %s", root.Text('g', 45), want.Text('g', 45))
+		}
+		// Bisection alone would need about 133 evaluations for 1e-40
+		if calls > 40 {
+			t.Errorf("BigBrentRoot took %d evaluations, want at most 40", calls)
+		}
+	})
+
+	t.Run("wallis_cubic", func(t *testing.T) {
+		// x³ - 2x - 5 has a single real root near 2.0946
+		f := func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+			r.Sub(r, NewBigFloat(2.0, x.Prec()))
+			r.Mul(r, x)
+			return r.Sub(r, NewBigFloat(5.0, x.Prec()))
+		}
+		root, err := BigBrentRoot(f, NewBigFloat(3.0, prec), NewBigFloat(2.0, prec), tol, prec)
+		if err != nil {
+			t.Fatalf("BigBrentRoot returned error: %v", err)
+		}
+		want, _ := NewBigFloatFromString("2.0945514815423265914823865405793029638573", prec)
+		if !BigFloatEqual(root, want, tol) {
+			t.Errorf("root = %s, want %s", root.Text('g', 45), want.Text('g', 45))
+		}
+	})
+
+	t.Run("root_at_endpoint", func(t *testing.T) {
+		f := func(x *BigFloat) *BigFloat {
+			return new(BigFloat).SetPrec(x.Prec()).Sub(x, NewBigFloat(1.0, x.Prec()))
+		}
+		root, err := BigBrentRoot(f, NewBigFloat(1.0, prec), NewBigFloat(4.0, prec), tol, prec)
+		if err != nil {
+			t.Fatalf("BigBrentRoot returned error: %v", err)
+		}
+		if root.Cmp(NewBigFloat(1.0, prec)) != 0 {
+			t.Errorf("root = %s, want 1", root.Text('g', 20))
+		}
+	})
+
+	t.Run("unbracketed_returns_error", func(t *testing.T) {
+		f := func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+			return r.Add(r, NewBigFloat(1.0, x.Prec()))
+		}
+		if root, err := BigBrentRoot(f, NewBigFloat(-1.0, prec), NewBigFloat(2.0, prec), tol, prec); err == nil {
+			t.Errorf("BigBrentRoot returned %s, want error", root.Text('g', 20))
+		}
+	})
+}
+
+func TestSolverContextCancellation(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	// x² + 1 has no real root, so Newton wanders forever
+	noRoot := func(x *BigFloat) *BigFloat {
+		r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+		return r.Add(r, NewBigFloat(1.0, x.Prec()))
+	}
+	noRootDeriv := func(x *BigFloat) *BigFloat {
+		return new(BigFloat).SetPrec(x.Prec()).Mul(x, NewBigFloat(2.0, x.Prec()))
+	}
+
+	t.Run("newton_deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := BigNewtonRootCtx(ctx, noRoot, noRootDeriv, NewBigFloat(0.5, prec), tol, 1<<30, prec)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("BigNewtonRootCtx error = %v, want context.DeadlineExceeded", err)
+		}
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("BigNewtonRootCtx took %v to notice the deadline", elapsed)
+		}
+	})
+
+	t.Run("brent_deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+
+		// A slow f makes the 1e-60 solve take far longer than the timeout
+		slow := func(x *BigFloat) *BigFloat {
+			time.Sleep(2 * time.Millisecond)
+			return new(BigFloat).SetPrec(x.Prec()).Sub(BigCos(x, x.Prec()), x)
+		}
+		_, err := BigBrentRootCtx(ctx, slow, NewBigFloat(0.0, prec), NewBigFloat(1.0, prec), tol, prec)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("BigBrentRootCtx error = %v, want context.DeadlineExceeded", err)
+		}
+	})
+
+	t.Run("already_canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if _, err := BigNewtonRootCtx(ctx, noRoot, noRootDeriv, NewBigFloat(0.5, prec), tol, 10, prec); !errors.Is(err, context.Canceled) {
+			t.Errorf("BigNewtonRootCtx error = %v, want context.Canceled", err)
+		}
+		calls := 0
+		f := func(x *BigFloat) *BigFloat {
+			calls++
+			return new(BigFloat).SetPrec(x.Prec()).Sub(x, NewBigFloat(0.5, x.Prec()))
+		}
+		if _, err := BigBrentRootCtx(ctx, f, NewBigFloat(0.0, prec), NewBigFloat(1.0, prec), tol, prec); !errors.Is(err, context.Canceled) {
+			t.Errorf("BigBrentRootCtx error = %v, want context.Canceled", err)
+		}
+		if calls != 0 {
+			t.Errorf("BigBrentRootCtx evaluated f %d times with a canceled context", calls)
+		}
+	})
+
+	t.Run("background_matches_plain", func(t *testing.T) {
+		f := func(x *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(x.Prec()).Mul(x, x)
+			return r.Sub(r, NewBigFloat(2.0, x.Prec()))
+		}
+		df := func(x *BigFloat) *BigFloat {
+			return new(BigFloat).SetPrec(x.Prec()).Mul(x, NewBigFloat(2.0, x.Prec()))
+		}
+		plain, _ := BigNewtonRoot(f, df, NewBigFloat(1.0, prec), tol, 20, prec)
+		withCtx, err := BigNewtonRootCtx(context.Background(), f, df, NewBigFloat(1.0, prec), tol, 20, prec)
+		if err != nil || withCtx.Cmp(plain) != 0 {
+			t.Errorf("BigNewtonRootCtx = %v, %v; want %s", withCtx, err, plain.Text('g', 30))
+		}
+	})
+}
@@ -3,6 +3,12 @@
 
 package bigmath
 
+import (
+	"math"
+	"math/big"
+	"sync"
+)
+
 // BigGamma computes the Gamma function Γ(x)
 // Uses Lanczos approximation for x > 0
 // For negative x, uses reflection formula: Γ(x) = π / (Γ(1-x) * sin(π*x))
@@ -101,50 +107,36 @@ func BigErf(x *BigFloat, prec uint) *BigFloat {
 	return getDispatcher().BigErfImpl(x, prec)
 }
 
-// bigErfSeries computes erf(x) using series expansion for small |x|
+// bigErfSeries computes erf(x) using the Maclaurin series
+// erf(x) = (2/√π) * sum_{n=0} (-1)^n * x^(2n+1) / (n! * (2n+1))
+// The alternating terms grow to about e^(x²) before decaying, so the sum is
+// carried with x²·log2(e) extra bits to absorb the cancellation.
 func bigErfSeries(x *BigFloat, workPrec, targetPrec uint) *BigFloat {
-	// Series expansion: erf(x) = (2/√π) * sum_{n=0} (-1)^n * x^(2n+1) / (n! * (2n+1))
-	twoOverSqrtPi := new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(2.0, workPrec), BigSqrt(BigPI(workPrec), workPrec))
-
-	result := new(BigFloat).SetPrec(workPrec).Set(x)
-	term := new(BigFloat).SetPrec(workPrec).Set(x)
-	x2 := new(BigFloat).SetPrec(workPrec).Mul(x, x)
-	factorial := NewBigFloat(1.0, workPrec)
-
-	// More lenient convergence threshold for better accuracy
-	convThreshold := new(BigFloat).SetPrec(workPrec).SetMantExp(NewBigFloat(1.0, workPrec), -int(workPrec+15))
-	resultAbs := new(BigFloat).SetPrec(workPrec).Abs(result)
-
-	for n := 1; n < 3000; n++ {
-		// term = (-1)^n * x^(2n+1) / (n! * (2n+1))
-		term.Mul(term, x2)
-		factorial.Mul(factorial, NewBigFloat(float64(n), workPrec))
-		denom := new(BigFloat).SetPrec(workPrec).Mul(factorial, NewBigFloat(float64(2*n+1), workPrec))
-		term.Quo(term, denom)
-
-		if n%2 == 1 {
-			// Odd n: subtract
-			result.Sub(result, term)
-		} else {
-			// Even n: add
-			result.Add(result, term)
+	xf, _ := x.Float64()
+	seriesPrec := workPrec + uint(xf*xf*math.Log2E) + 16
+
+	twoOverSqrtPi := new(BigFloat).SetPrec(seriesPrec).Quo(NewBigFloat(2.0, seriesPrec), BigSqrt(BigPI(seriesPrec), seriesPrec))
+
+	result := new(BigFloat).SetPrec(seriesPrec).Set(x)
+	power := new(BigFloat).SetPrec(seriesPrec).Set(x) // (-1)^n * x^(2n+1) / n!
+	negX2 := new(BigFloat).SetPrec(seriesPrec).Mul(x, x)
+	negX2.Neg(negX2)
+
+	convThreshold := new(BigFloat).SetPrec(seriesPrec).SetMantExp(NewBigFloat(1.0, seriesPrec), -int(seriesPrec))
+	resultAbs := new(BigFloat).SetPrec(seriesPrec).Abs(result)
+
+	for n := 1; n < 100000; n++ {
+		power.Mul(power, negX2)
+		power.Quo(power, NewBigFloat(float64(n), seriesPrec))
+		term := new(BigFloat).SetPrec(seriesPrec).Quo(power, NewBigFloat(float64(2*n+1), seriesPrec))
+		result.Add(result, term)
+
+		// Stop once the term is negligible relative to the sum
+		resultAbs.Abs(result)
+		termAbs := term.Abs(term)
+		if resultAbs.Sign() == 0 || termAbs.Cmp(new(BigFloat).Mul(resultAbs, convThreshold)) < 0 {
+			break
 		}
-
-		// Check convergence relative to result magnitude
-		termAbs := new(BigFloat).SetPrec(workPrec).Abs(term)
-		if termAbs.Cmp(convThreshold) < 0 {
-			// Also check relative convergence
-			if resultAbs.Sign() > 0 {
-				relErr := new(BigFloat).SetPrec(workPrec).Quo(termAbs, resultAbs)
-				if relErr.Cmp(convThreshold) < 0 {
-					break
-				}
-			} else {
-				break
-			}
-		}
-		resultAbs.Set(result)
-		resultAbs.Abs(resultAbs)
 	}
 
 	result.Mul(result, twoOverSqrtPi)
@@ -153,8 +145,16 @@ func bigErfSeries(x *BigFloat, workPrec, targetPrec uint) *BigFloat {
 
 // bigErfcImproved computes erfc(x) with improved accuracy for moderate x
 func bigErfcImproved(x *BigFloat, workPrec, targetPrec uint) *BigFloat {
-	// For moderate x (0.5 <= x < 4.0), use improved asymptotic expansion
-	// with more terms and better convergence
+	// The asymptotic expansion cannot be more accurate than its smallest term,
+	// which is about e^(-x²); below that, use the series with guard bits
+	xf, _ := x.Float64()
+	if xf*xf < float64(workPrec+32)*math.Ln2 {
+		// erf(x) is needed to x²·log2(e) more bits than erfc(x) itself
+		seriesPrec := workPrec + uint(xf*xf*math.Log2E)
+		one := NewBigFloat(1.0, workPrec)
+		result := new(BigFloat).SetPrec(workPrec).Sub(one, bigErfSeries(x, seriesPrec, seriesPrec))
+		return new(BigFloat).SetPrec(targetPrec).Set(result)
+	}
 
 	x2 := new(BigFloat).SetPrec(workPrec).Mul(x, x)
 	expNegX2 := BigExp(new(BigFloat).SetPrec(workPrec).Neg(x2), workPrec)
@@ -177,11 +177,8 @@ func bigErfcImproved(x *BigFloat, workPrec, targetPrec uint) *BigFloat {
 	for n := 1; n < 300; n++ {
 		series.Add(series, term)
 
-		// Next term: a_{n+1} = a_n * (2n+1)/(2n+2) * (-1) / x²
-		coeff := new(BigFloat).SetPrec(workPrec).Quo(
-			NewBigFloat(float64(2*n+1), workPrec),
-			NewBigFloat(float64(2*n+2), workPrec),
-		)
+		// Next term: a_{n+1} = a_n * (2n+1)/2 * (-1) / x²
+		coeff := NewBigFloat(float64(2*n+1)/2, workPrec)
 		term.Mul(term, coeff)
 		term.Mul(term, x2Inv)
 		term.Neg(term) // Alternating sign
@@ -302,11 +299,10 @@ func bigBesselY0(x *BigFloat, workPrec, targetPrec uint) *BigFloat {
 	firstTerm.Mul(firstTerm, j0)
 
 	// Series term: sum_{k=0} (-1)^k * H_k * (x/2)^(2k) / (k!)^2
-	// H_k ≈ ln(k) + γ + 1/(2k) - 1/(12k²) + ... for large k
-	// For small k, compute H_k exactly
 	xHalf2 := new(BigFloat).SetPrec(workPrec).Mul(xHalf, xHalf)
 	series := NewBigFloat(0.0, workPrec)
-	harmonic := NewBigFloat(0.0, workPrec) // H_0 = 0
+	harmonics := newHarmonicSum(workPrec)
+	harmonic := harmonics.value() // H_0 = 0
 	kFactorial := NewBigFloat(1.0, workPrec)
 	term := NewBigFloat(1.0, workPrec) // (x/2)^(2k) / (k!)^2, starts at k=0
 
@@ -314,7 +310,8 @@ func bigBesselY0(x *BigFloat, workPrec, targetPrec uint) *BigFloat {
 
 	for k := 0; k < 1000; k++ {
 		if k > 0 {
-			harmonic.Add(harmonic, new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(1.0, workPrec), NewBigFloat(float64(k), workPrec)))
+			harmonics.next()
+			harmonic = harmonics.value()
 			term.Mul(term, xHalf2)
 			kFactorial.Mul(kFactorial, NewBigFloat(float64(k), workPrec))
 			kFactorial2 := new(BigFloat).SetPrec(workPrec).Mul(kFactorial, kFactorial)
@@ -368,7 +365,9 @@ func bigBesselY1(x *BigFloat, workPrec, targetPrec uint) *BigFloat {
 	// Series term: sum_{k=0} (-1)^k * (H_k + H_{k+1}) * (x/2)^(2k+1) / (k! * (k+1)!)
 	xHalf2 := new(BigFloat).SetPrec(workPrec).Mul(xHalf, xHalf)
 	series := NewBigFloat(0.0, workPrec)
-	harmonic := NewBigFloat(0.0, workPrec) // H_0 = 0
+	harmonics := newHarmonicSum(workPrec)
+	harmonic := harmonics.value() // H_0 = 0
+	var harmonicKPlus1 *BigFloat  // H_{k+1}, reused as H_k on the next step
 	kFactorial := NewBigFloat(1.0, workPrec)
 	kPlus1Factorial := NewBigFloat(1.0, workPrec)      // 1! = 1
 	term := new(BigFloat).SetPrec(workPrec).Set(xHalf) // (x/2)^(2k+1) / (k! * (k+1)!), starts at k=0
@@ -377,15 +376,15 @@ func bigBesselY1(x *BigFloat, workPrec, targetPrec uint) *BigFloat {
 
 	for k := 0; k < 1000; k++ {
 		if k > 0 {
-			harmonic.Add(harmonic, new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(1.0, workPrec), NewBigFloat(float64(k), workPrec)))
+			harmonic = harmonicKPlus1
 			term.Mul(term, xHalf2)
 			kFactorial.Mul(kFactorial, NewBigFloat(float64(k), workPrec))
 			kPlus1Factorial.Mul(kPlus1Factorial, NewBigFloat(float64(k+1), workPrec))
 			term.Quo(term, new(BigFloat).SetPrec(workPrec).Mul(kFactorial, kPlus1Factorial))
 		}
 
-		harmonicKPlus1 := new(BigFloat).SetPrec(workPrec).Add(harmonic,
-			new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(1.0, workPrec), NewBigFloat(float64(k+1), workPrec)))
+		harmonics.next()
+		harmonicKPlus1 = harmonics.value()
 		harmonicSum := new(BigFloat).SetPrec(workPrec).Add(harmonic, harmonicKPlus1)
 
 		seriesTerm := new(BigFloat).SetPrec(workPrec).Mul(harmonicSum, term)
@@ -413,3 +412,509 @@ func bigBesselY1(x *BigFloat, workPrec, targetPrec uint) *BigFloat {
 
 	return new(BigFloat).SetPrec(targetPrec).Set(result)
 }
+
+// bernoulliCache holds the Bernoulli numbers B_0, B_1, ... computed so far
+var bernoulliCache struct {
+	mu     sync.Mutex
+	values []*big.Rat
+}
+
+// bigBernoulli returns the Bernoulli number B_n as an exact rational
+// (with the convention B_1 = -1/2), extending the cache via the recurrence
+// B_m = -1/(m+1) * Σ_{k<m} C(m+1, k) B_k
+func bigBernoulli(n int) *big.Rat {
+	bernoulliCache.mu.Lock()
+	defer bernoulliCache.mu.Unlock()
+
+	for m := len(bernoulliCache.values); m <= n; m++ {
+		if m == 0 {
+			bernoulliCache.values = append(bernoulliCache.values, big.NewRat(1, 1))
+			continue
+		}
+		sum := new(big.Rat)
+		binom := big.NewInt(1) // C(m+1, 0)
+		for k := 0; k < m; k++ {
+			if k > 0 {
+				// C(m+1, k) = C(m+1, k-1) * (m+2-k) / k
+				binom.Mul(binom, big.NewInt(int64(m+2-k)))
+				binom.Quo(binom, big.NewInt(int64(k)))
+			}
+			if bernoulliCache.values[k].Sign() == 0 {
+				continue
+			}
+			term := new(big.Rat).SetInt(binom)
+			sum.Add(sum, term.Mul(term, bernoulliCache.values[k]))
+		}
+		sum.Quo(sum, big.NewRat(int64(-(m+1)), 1))
+		bernoulliCache.values = append(bernoulliCache.values, sum)
+	}
+
+	return new(big.Rat).Set(bernoulliCache.values[n])
+}
+
+// BigDigamma computes the digamma function ψ(x) = Γ'(x)/Γ(x)
+// For x > 0 the argument is shifted upward with ψ(x) = ψ(x+1) - 1/x and the
+// asymptotic series ψ(x) ≈ ln(x) - 1/(2x) - Σ B_2k/(2k x^2k) is applied.
+// Negative x uses the reflection formula ψ(x) = ψ(1-x) - π·cot(πx).
+// Returns +Inf at the poles (zero and the negative integers).
+func BigDigamma(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	if x.IsInf() {
+		if x.Sign() > 0 {
+			return new(BigFloat).SetPrec(prec).SetInf(false)
+		}
+		return NewBigFloat(math.NaN(), prec)
+	}
+	if x.Sign() <= 0 && BigIsInteger(x) {
+		return new(BigFloat).SetPrec(prec).SetInf(false)
+	}
+
+	workPrec := prec + 32
+
+	if x.Sign() < 0 {
+		// ψ(x) = ψ(1-x) - π·cot(πx)
+		oneMinusX := new(BigFloat).SetPrec(workPrec).Sub(NewBigFloat(1.0, workPrec), x)
+		piX := new(BigFloat).SetPrec(workPrec).Mul(BigPI(workPrec), x)
+		cot := new(BigFloat).SetPrec(workPrec).Quo(BigCos(piX, workPrec), BigSin(piX, workPrec))
+		cot.Mul(cot, BigPI(workPrec))
+		result := new(BigFloat).SetPrec(workPrec).Sub(BigDigamma(oneMinusX, workPrec), cot)
+		return new(BigFloat).SetPrec(prec).Set(result)
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(bigDigammaPositive(x, workPrec))
+}
+
+// bigDigammaPositive computes ψ(x) for x > 0 at the given precision
+func bigDigammaPositive(x *BigFloat, prec uint) *BigFloat {
+	one := NewBigFloat(1.0, prec)
+
+	// Shift x past prec/4 so that the asymptotic series reaches full precision
+	// after a few dozen terms; its error is roughly e^(-2πx)
+	shift := NewBigFloat(float64(prec/4), prec)
+	z := new(BigFloat).SetPrec(prec).Set(x)
+	result := NewBigFloat(0.0, prec)
+	for z.Cmp(shift) < 0 {
+		result.Sub(result, new(BigFloat).SetPrec(prec).Quo(one, z))
+		z.Add(z, one)
+	}
+
+	// ln(z) - 1/(2z)
+	result.Add(result, BigLog(z, prec))
+	result.Sub(result, new(BigFloat).SetPrec(prec).Quo(NewBigFloat(0.5, prec), z))
+
+	// - Σ B_2k / (2k z^2k)
+	invZ2 := new(BigFloat).SetPrec(prec).Quo(one, z)
+	invZ2.Mul(invZ2, invZ2)
+	zPow := new(BigFloat).SetPrec(prec).Set(invZ2) // z^-2k
+	threshold := new(BigFloat).SetMantExp(NewBigFloat(1.0, prec), -int(prec))
+	for k := 1; k <= int(prec); k++ {
+		b := new(BigFloat).SetPrec(prec).SetRat(bigBernoulli(2 * k))
+		term := new(BigFloat).SetPrec(prec).Mul(b, zPow)
+		term.Quo(term, NewBigFloat(float64(2*k), prec))
+		result.Sub(result, term)
+
+		if new(BigFloat).Abs(term).Cmp(threshold) < 0 {
+			break
+		}
+		zPow.Mul(zPow, invZ2)
+	}
+
+	return result
+}
+
+// BigErfInv computes the inverse error function, the y with erf(y) = x
+// Newton-Raphson iteration y ← y - (erf(y) - x) / (2/√π · e^(-y²)) is
+// seeded with Winitzki's closed-form approximation. Returns ±Inf for x = ±1
+// and the NaN sentinel for |x| > 1.
+func BigErfInv(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	if x.Sign() == 0 {
+		return NewBigFloat(0.0, prec)
+	}
+	one := NewBigFloat(1.0, prec)
+	switch new(BigFloat).Abs(x).Cmp(one) {
+	case 0:
+		return new(BigFloat).SetPrec(prec).SetInf(x.Sign() < 0)
+	case 1:
+		return NewBigFloat(math.NaN(), prec)
+	}
+
+	workPrec := prec + 32
+
+	// Winitzki: erf⁻¹(x) ≈ sgn(x)·√(√((2/(πa) + ln(1-x²)/2)² - ln(1-x²)/a) - (2/(πa) + ln(1-x²)/2))
+	xf, _ := x.Float64()
+	const a = 0.147
+	ln1mx2 := math.Log1p(-xf * xf)
+	t := 2/(math.Pi*a) + ln1mx2/2
+	seed := math.Sqrt(math.Sqrt(t*t-ln1mx2/a) - t)
+	if xf < 0 {
+		seed = -seed
+	}
+
+	twoOverSqrtPi := new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(2.0, workPrec), BigSqrt(BigPI(workPrec), workPrec))
+	y := NewBigFloat(seed, workPrec)
+	for i := 0; i < solverMaxIterations; i++ {
+		// f(y) = erf(y) - x, f'(y) = 2/√π · e^(-y²)
+		fy := new(BigFloat).SetPrec(workPrec).Sub(BigErf(y, workPrec), x)
+		if fy.Sign() == 0 {
+			break
+		}
+		negY2 := new(BigFloat).SetPrec(workPrec).Mul(y, y)
+		negY2.Neg(negY2)
+		dfy := new(BigFloat).SetPrec(workPrec).Mul(twoOverSqrtPi, BigExp(negY2, workPrec))
+
+		step := new(BigFloat).SetPrec(workPrec).Quo(fy, dfy)
+		y.Sub(y, step)
+		if solverConverged(step, y, prec) {
+			break
+		}
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(y)
+}
+
+// BigLogGamma computes ln|Γ(x)|
+// For x > 0 the argument is shifted upward with ln Γ(x) = ln Γ(x+n) - ln(x(x+1)...(x+n-1))
+// and Stirling's series ln Γ(z) ≈ (z-½)ln z - z + ½ln(2π) + Σ B_2k/(2k(2k-1)z^(2k-1))
+// is applied. Negative x uses the reflection formula
+// ln|Γ(x)| = ln π - ln|sin(πx)| - ln Γ(1-x).
+// Returns +Inf at the poles (zero and the negative integers).
+func BigLogGamma(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	if x.IsInf() || bigGammaIsPole(x) {
+		return new(BigFloat).SetPrec(prec).SetInf(false)
+	}
+
+	workPrec := prec + 32
+
+	if x.Sign() < 0 {
+		oneMinusX := new(BigFloat).SetPrec(workPrec).Sub(NewBigFloat(1.0, workPrec), x)
+		piX := new(BigFloat).SetPrec(workPrec).Mul(BigPI(workPrec), x)
+		sinPiX := BigSin(piX, workPrec)
+		sinPiX.Abs(sinPiX)
+
+		result := BigLog(BigPI(workPrec), workPrec)
+		result.Sub(result, BigLog(sinPiX, workPrec))
+		result.Sub(result, bigLogGammaPositive(oneMinusX, workPrec))
+		return new(BigFloat).SetPrec(prec).Set(result)
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(bigLogGammaPositive(x, workPrec))
+}
+
+// bigLogGammaPositive computes ln Γ(x) for x > 0 at the given precision
+func bigLogGammaPositive(x *BigFloat, prec uint) *BigFloat {
+	one := NewBigFloat(1.0, prec)
+
+	// Shift x past prec/4 as in bigDigammaPositive, accumulating x(x+1)...(x+n-1)
+	shift := NewBigFloat(float64(prec/4), prec)
+	z := new(BigFloat).SetPrec(prec).Set(x)
+	product := NewBigFloat(1.0, prec)
+	for z.Cmp(shift) < 0 {
+		product.Mul(product, z)
+		z.Add(z, one)
+	}
+
+	// (z-½)ln z - z + ½ln(2π)
+	zMinusHalf := new(BigFloat).SetPrec(prec).Sub(z, NewBigFloat(0.5, prec))
+	result := new(BigFloat).SetPrec(prec).Mul(zMinusHalf, BigLog(z, prec))
+	result.Sub(result, z)
+	halfLn2Pi := BigLog(BigTwoPI(prec), prec)
+	halfLn2Pi.Quo(halfLn2Pi, NewBigFloat(2.0, prec))
+	result.Add(result, halfLn2Pi)
+
+	// + Σ B_2k / (2k(2k-1) z^(2k-1))
+	invZ := new(BigFloat).SetPrec(prec).Quo(one, z)
+	invZ2 := new(BigFloat).SetPrec(prec).Mul(invZ, invZ)
+	zPow := new(BigFloat).SetPrec(prec).Set(invZ) // z^-(2k-1)
+	threshold := new(BigFloat).SetMantExp(NewBigFloat(1.0, prec), -int(prec))
+	for k := 1; k <= int(prec); k++ {
+		b := new(BigFloat).SetPrec(prec).SetRat(bigBernoulli(2 * k))
+		term := new(BigFloat).SetPrec(prec).Mul(b, zPow)
+		term.Quo(term, NewBigFloat(float64(2*k*(2*k-1)), prec))
+		result.Add(result, term)
+
+		if new(BigFloat).Abs(term).Cmp(threshold) < 0 {
+			break
+		}
+		zPow.Mul(zPow, invZ2)
+	}
+
+	if product.Cmp(one) != 0 {
+		result.Sub(result, BigLog(product, prec))
+	}
+	return result
+}
+
+// bigGammaIsPole reports whether x is zero or a negative integer
+func bigGammaIsPole(x *BigFloat) bool {
+	return x.Sign() <= 0 && BigIsInteger(x)
+}
+
+// bigGammaIsNegative reports whether Γ(x) < 0, which holds for negative
+// non-integer x with an odd floor
+func bigGammaIsNegative(x *BigFloat) bool {
+	if x.Sign() >= 0 {
+		return false
+	}
+	floor, _ := BigFloor(x, x.Prec()).Int64()
+	return floor%2 != 0
+}
+
+// BigLogBeta computes ln|B(a, b)| = ln|Γ(a)| + ln|Γ(b)| - ln|Γ(a+b)|
+// Working in logarithms avoids the overflow of the individual Gamma values.
+// Returns +Inf if a or b is a pole of Γ (zero or a negative integer), and
+// -Inf if only a+b is, where B(a, b) = 0.
+func BigLogBeta(a, b *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = a.Prec()
+	}
+
+	workPrec := prec + 32
+	sum := new(BigFloat).SetPrec(workPrec).Add(a, b)
+	if bigGammaIsPole(a) || bigGammaIsPole(b) {
+		return new(BigFloat).SetPrec(prec).SetInf(false)
+	}
+	if bigGammaIsPole(sum) {
+		return new(BigFloat).SetPrec(prec).SetInf(true)
+	}
+
+	result := BigLogGamma(a, workPrec)
+	result.Add(result, BigLogGamma(b, workPrec))
+	result.Sub(result, BigLogGamma(sum, workPrec))
+	return new(BigFloat).SetPrec(prec).Set(result)
+}
+
+// BigBeta computes the Beta function B(a, b) = Γ(a)Γ(b)/Γ(a+b)
+// It is evaluated as ±exp(BigLogBeta(a, b)) with the sign taken from the
+// Gamma factors. Returns +Inf if a or b is a pole of Γ and 0 if only a+b is.
+func BigBeta(a, b *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = a.Prec()
+	}
+
+	workPrec := prec + 32
+	logBeta := BigLogBeta(a, b, workPrec)
+	if logBeta.IsInf() {
+		if logBeta.Sign() > 0 {
+			return new(BigFloat).SetPrec(prec).SetInf(false)
+		}
+		return NewBigFloat(0.0, prec)
+	}
+
+	result := BigExp(logBeta, workPrec)
+	sum := new(BigFloat).SetPrec(workPrec).Add(a, b)
+	if bigGammaIsNegative(a) != bigGammaIsNegative(b) != bigGammaIsNegative(sum) {
+		result.Neg(result)
+	}
+	return new(BigFloat).SetPrec(prec).Set(result)
+}
+
+// BigGammaP computes the regularized lower incomplete gamma function
+// P(a, x) = γ(a, x)/Γ(a) for a > 0 and x >= 0
+// For x < a+1 the series P(a,x) = x^a e^(-x)/Γ(a) Σ x^n/(a(a+1)...(a+n)) is
+// summed; otherwise P = 1 - Q with Q from its continued fraction.
+// Returns the NaN sentinel for a <= 0 or x < 0.
+func BigGammaP(a, x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = a.Prec()
+	}
+	p, _ := bigIncompleteGamma(a, x, prec)
+	return p
+}
+
+// BigGammaQ computes the regularized upper incomplete gamma function
+// Q(a, x) = Γ(a, x)/Γ(a) = 1 - P(a, x) for a > 0 and x >= 0
+// For x >= a+1 the Legendre continued fraction is evaluated directly, so
+// small tail values keep their relative accuracy.
+// Returns the NaN sentinel for a <= 0 or x < 0.
+func BigGammaQ(a, x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = a.Prec()
+	}
+	_, q := bigIncompleteGamma(a, x, prec)
+	return q
+}
+
+// bigIncompleteGamma returns P(a, x) and Q(a, x) rounded to prec
+func bigIncompleteGamma(a, x *BigFloat, prec uint) (*BigFloat, *BigFloat) {
+	if a.Sign() <= 0 || a.IsInf() || x.Sign() < 0 {
+		nan := NewBigFloat(math.NaN(), prec)
+		return nan, NewBigFloat(math.NaN(), prec)
+	}
+	if x.Sign() == 0 {
+		return NewBigFloat(0.0, prec), NewBigFloat(1.0, prec)
+	}
+	if x.IsInf() {
+		return NewBigFloat(1.0, prec), NewBigFloat(0.0, prec)
+	}
+
+	workPrec := prec + 32
+	one := NewBigFloat(1.0, workPrec)
+
+	// x^a e^(-x) / Γ(a) = exp(a ln x - x - ln Γ(a))
+	logPrefactor := new(BigFloat).SetPrec(workPrec).Mul(a, BigLog(x, workPrec))
+	logPrefactor.Sub(logPrefactor, x)
+	logPrefactor.Sub(logPrefactor, BigLogGamma(a, workPrec))
+	prefactor := BigExp(logPrefactor, workPrec)
+
+	eps := new(BigFloat).SetMantExp(NewBigFloat(1.0, workPrec), -int(workPrec))
+	aPlusOne := new(BigFloat).SetPrec(workPrec).Add(a, one)
+
+	if x.Cmp(aPlusOne) < 0 {
+		// Series: Σ x^n / (a(a+1)...(a+n))
+		ap := new(BigFloat).SetPrec(workPrec).Set(a)
+		del := new(BigFloat).SetPrec(workPrec).Quo(one, a)
+		sum := new(BigFloat).SetPrec(workPrec).Set(del)
+		for n := 1; n < 100000; n++ {
+			ap.Add(ap, one)
+			del.Mul(del, x)
+			del.Quo(del, ap)
+			sum.Add(sum, del)
+			if new(BigFloat).Abs(del).Cmp(new(BigFloat).Mul(sum, eps)) < 0 {
+				break
+			}
+		}
+
+		p := new(BigFloat).SetPrec(workPrec).Mul(sum, prefactor)
+		q := new(BigFloat).SetPrec(workPrec).Sub(one, p)
+		return new(BigFloat).SetPrec(prec).Set(p), new(BigFloat).SetPrec(prec).Set(q)
+	}
+
+	// Continued fraction for Q, evaluated with the modified Lentz method:
+	// Q = prefactor * 1/(x+1-a- 1(1-a)/(x+3-a- 2(2-a)/(x+5-a- ...)))
+	tiny := new(BigFloat).SetMantExp(NewBigFloat(1.0, workPrec), -4*int(workPrec))
+	b := new(BigFloat).SetPrec(workPrec).Add(x, one)
+	b.Sub(b, a) // x + 1 - a
+	c := new(BigFloat).SetPrec(workPrec).Quo(one, tiny)
+	d := new(BigFloat).SetPrec(workPrec).Quo(one, b)
+	h := new(BigFloat).SetPrec(workPrec).Set(d)
+	two := NewBigFloat(2.0, workPrec)
+	for i := 1; i < 100000; i++ {
+		// an = -i(i - a)
+		fi := NewBigFloat(float64(i), workPrec)
+		an := new(BigFloat).SetPrec(workPrec).Sub(fi, a)
+		an.Mul(an, fi)
+		an.Neg(an)
+		b.Add(b, two)
+
+		d.Mul(an, d)
+		d.Add(d, b)
+		if new(BigFloat).Abs(d).Cmp(tiny) < 0 {
+			d.Set(tiny)
+		}
+		c.Quo(an, c)
+		c.Add(c, b)
+		if new(BigFloat).Abs(c).Cmp(tiny) < 0 {
+			c.Set(tiny)
+		}
+		d.Quo(one, d)
+
+		del := new(BigFloat).SetPrec(workPrec).Mul(d, c)
+		h.Mul(h, del)
+		del.Sub(del, one)
+		if del.Abs(del).Cmp(eps) < 0 {
+			break
+		}
+	}
+
+	q := new(BigFloat).SetPrec(workPrec).Mul(h, prefactor)
+	p := new(BigFloat).SetPrec(workPrec).Sub(one, q)
+	return new(BigFloat).SetPrec(prec).Set(p), new(BigFloat).SetPrec(prec).Set(q)
+}
+
+// BigZeta computes the Riemann zeta function ζ(s)
+// For s >= 0 it uses Borwein's algorithm on the alternating (eta) series:
+// ζ(s) = -1/(d_n(1-2^(1-s))) Σ_{k<n} (-1)^k (d_k - d_n)/(k+1)^s with
+// d_k = n Σ_{i<=k} (n+i-1)! 4^i / ((n-i)! (2i)!), whose error is about
+// (3+√8)^-n. For s < 0 it uses the functional equation
+// ζ(s) = 2^s π^(s-1) sin(πs/2) Γ(1-s) ζ(1-s).
+// Returns +Inf at the pole s = 1.
+func BigZeta(s *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = s.Prec()
+	}
+
+	one := NewBigFloat(1.0, prec)
+	if s.Cmp(one) == 0 {
+		return new(BigFloat).SetPrec(prec).SetInf(false)
+	}
+	if s.IsInf() {
+		if s.Sign() > 0 {
+			return NewBigFloat(1.0, prec)
+		}
+		return NewBigFloat(math.NaN(), prec)
+	}
+
+	workPrec := prec + 32
+
+	if s.Sign() < 0 {
+		// Trivial zeros at the negative even integers
+		half := new(BigFloat).SetPrec(workPrec).Quo(s, NewBigFloat(2.0, workPrec))
+		if BigIsInteger(half) {
+			return NewBigFloat(0.0, prec)
+		}
+
+		oneMinusS := new(BigFloat).SetPrec(workPrec).Sub(NewBigFloat(1.0, workPrec), s)
+		sMinusOne := new(BigFloat).SetPrec(workPrec).Neg(oneMinusS)
+		pi := BigPI(workPrec)
+
+		// 2^s π^(s-1) sin(πs/2) Γ(1-s) ζ(1-s), with Γ(1-s) > 0 from BigLogGamma
+		result := BigPow(NewBigFloat(2.0, workPrec), s, workPrec)
+		result.Mul(result, BigPow(pi, sMinusOne, workPrec))
+		result.Mul(result, BigSin(new(BigFloat).SetPrec(workPrec).Mul(pi, half), workPrec))
+		result.Mul(result, BigExp(BigLogGamma(oneMinusS, workPrec), workPrec))
+		result.Mul(result, bigZetaBorwein(oneMinusS, workPrec))
+		return new(BigFloat).SetPrec(prec).Set(result)
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(bigZetaBorwein(s, workPrec))
+}
+
+// bigZetaBorwein evaluates ζ(s) for s >= 0, s != 1 with Borwein's algorithm
+func bigZetaBorwein(s *BigFloat, prec uint) *BigFloat {
+	// (3+√8)^-n < 2^-prec
+	n := int(float64(prec)*math.Ln2/math.Log(3+math.Sqrt(8))) + 2
+
+	// d_k as exact rationals: term_i = (n+i-1)! 4^i / ((n-i)! (2i)!)
+	d := make([]*big.Rat, n+1)
+	term := new(big.Rat).SetFrac(new(big.Int).MulRange(1, int64(n-1)), new(big.Int).MulRange(1, int64(n))) // i = 0: (n-1)!/n!
+	sum := new(big.Rat).Set(term)
+	d[0] = new(big.Rat).Mul(sum, big.NewRat(int64(n), 1))
+	for i := 1; i <= n; i++ {
+		// term_i = term_{i-1} * (n+i-1)(n-i+1) * 4 / ((2i-1)(2i))
+		term.Mul(term, big.NewRat(int64(4*(n+i-1)*(n-i+1)), int64((2*i-1)*(2*i))))
+		sum.Add(sum, term)
+		d[i] = new(big.Rat).Mul(sum, big.NewRat(int64(n), 1))
+	}
+
+	dn := new(BigFloat).SetPrec(prec).SetRat(d[n])
+	result := NewBigFloat(0.0, prec)
+	for k := 0; k < n; k++ {
+		// (-1)^k (d_k - d_n) / (k+1)^s
+		numer := new(BigFloat).SetPrec(prec).SetRat(d[k])
+		numer.Sub(numer, dn)
+		numer.Quo(numer, BigPow(NewBigFloat(float64(k+1), prec), s, prec))
+		if k%2 == 1 {
+			numer.Neg(numer)
+		}
+		result.Add(result, numer)
+	}
+
+	// Divide by -d_n (1 - 2^(1-s))
+	oneMinusS := new(BigFloat).SetPrec(prec).Sub(NewBigFloat(1.0, prec), s)
+	denom := new(BigFloat).SetPrec(prec).Sub(NewBigFloat(1.0, prec), BigPow(NewBigFloat(2.0, prec), oneMinusS, prec))
+	denom.Mul(denom, dn)
+	denom.Neg(denom)
+
+	return result.Quo(result, denom)
+}
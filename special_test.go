@@ -5,6 +5,7 @@ package bigmath
 
 import (
 	"math"
+	"math/big"
 	"testing"
 )
 
@@ -276,6 +277,18 @@ func TestBigErfc(t *testing.T) {
 		})
 	}
 
+	// Large arguments go through 1 - erf(x) at raised precision, which needs
+	// π beyond DefaultPrecision bits to avoid catastrophic cancellation
+	t.Run("large_arguments", func(t *testing.T) {
+		for _, x := range []float64{10.0, 13.0, 13.5, 14.0, 20.0} {
+			got, _ := BigErfc(NewBigFloat(x, prec), prec).Float64()
+			want := math.Erfc(x)
+			if math.Abs(got-want) > 1e-14*want {
+				t.Errorf("BigErfc(%g) = %g, want %g", x, got, want)
+			}
+		}
+	})
+
 	// Property: erfc(-x) = 2 - erfc(x)
 	t.Run("reflection_property", func(t *testing.T) {
 		testCases := []float64{0.5, 1.0, 2.0, 3.0}
@@ -494,3 +507,382 @@ func TestBigBesselY(t *testing.T) {
 		}
 	})
 }
+
+func TestBigBernoulli(t *testing.T) {
+	tests := []struct {
+		n        int
+		num, den int64
+	}{
+		{0, 1, 1},
+		{1, -1, 2},
+		{2, 1, 6},
+		{3, 0, 1},
+		{4, -1, 30},
+		{12, -691, 2730},
+		{20, -174611, 330},
+	}
+
+	for _, tt := range tests {
+		got := bigBernoulli(tt.n)
+		want := big.NewRat(tt.num, tt.den)
+		if got.Cmp(want) != 0 {
+			t.Errorf("bigBernoulli(%d) = %s, want %s", tt.n, got.RatString(), want.RatString())
+		}
+	}
+}
+
+func TestBigDigamma(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-40", prec)
+	gamma := BigEulerGamma(prec)
+	ln2 := BigLog(NewBigFloat(2.0, prec), prec)
+
+	within := func(t *testing.T, label string, got, want *BigFloat) {
+		t.Helper()
+		diff := new(BigFloat).SetPrec(prec).Sub(got, want)
+		if diff.Abs(diff).Cmp(tolerance) > 0 {
+			t.Errorf("%s = %s, want %s", label, got.Text('g', 45), want.Text('g', 45))
+		}
+	}
+
+	t.Run("psi_one", func(t *testing.T) {
+		// ψ(1) = -γ
+		within(t, "ψ(1)", BigDigamma(NewBigFloat(1.0, prec), prec), new(BigFloat).SetPrec(prec).Neg(gamma))
+	})
+
+	t.Run("psi_half", func(t *testing.T) {
+		// ψ(1/2) = -γ - 2ln2
+		want := new(BigFloat).SetPrec(prec).Add(gamma, ln2)
+		want.Add(want, ln2)
+		want.Neg(want)
+		within(t, "ψ(1/2)", BigDigamma(NewBigFloat(0.5, prec), prec), want)
+	})
+
+	t.Run("psi_integer", func(t *testing.T) {
+		// ψ(n) = H_{n-1} - γ
+		for _, n := range []int{2, 10, 50} {
+			want := new(BigFloat).SetPrec(prec).Sub(BigHarmonic(n-1, prec), gamma)
+			within(t, "ψ(n)", BigDigamma(NewBigFloat(float64(n), prec), prec), want)
+		}
+	})
+
+	t.Run("recurrence", func(t *testing.T) {
+		// ψ(x+1) - ψ(x) = 1/x across [0.5, 50]
+		for _, v := range []float64{0.5, 0.75, 3.7, 12.25, 49.5} {
+			x := NewBigFloat(v, prec)
+			xPlus1 := new(BigFloat).SetPrec(prec).Add(x, NewBigFloat(1.0, prec))
+			got := new(BigFloat).SetPrec(prec).Sub(BigDigamma(xPlus1, prec), BigDigamma(x, prec))
+			want := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1.0, prec), x)
+			within(t, "ψ(x+1) - ψ(x)", got, want)
+		}
+	})
+
+	t.Run("negative_argument", func(t *testing.T) {
+		// ψ(-1/2) = ψ(1/2) + 2
+		want := new(BigFloat).SetPrec(prec).Sub(NewBigFloat(2.0, prec), gamma)
+		want.Sub(want, ln2)
+		want.Sub(want, ln2)
+		within(t, "ψ(-1/2)", BigDigamma(NewBigFloat(-0.5, prec), prec), want)
+	})
+
+	t.Run("poles", func(t *testing.T) {
+		for _, v := range []float64{0, -1, -3} {
+			got := BigDigamma(NewBigFloat(v, prec), prec)
+			if !got.IsInf() || got.Sign() < 0 {
+				t.Errorf("BigDigamma(%g) = %s, want +Inf", v, got.Text('g', 10))
+			}
+		}
+	})
+}
+
+func TestBigErfInv(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-40", prec)
+
+	t.Run("round_trip", func(t *testing.T) {
+		for _, v := range []float64{-0.999, -0.9, -0.5, -0.1, 1e-10, 0.25, 0.7, 0.95, 0.999} {
+			x := NewBigFloat(v, prec)
+			y := BigErfInv(x, prec)
+			diff := new(BigFloat).SetPrec(prec).Sub(BigErf(y, prec), x)
+			if diff.Abs(diff).Cmp(tolerance) > 0 {
+				t.Errorf("erf(BigErfInv(%g)) - %g = %s, want within 1e-40", v, v, diff.Text('g', 10))
+			}
+		}
+	})
+
+	t.Run("known_value", func(t *testing.T) {
+		// erf⁻¹(0.5) = 0.4769362762044698733814...
+		got, _ := BigErfInv(NewBigFloat(0.5, prec), prec).Float64()
+		if math.Abs(got-0.4769362762044699) > 1e-15 {
+			t.Errorf("BigErfInv(0.5) = %.17g, want 0.4769362762044699", got)
+		}
+	})
+
+	t.Run("odd_symmetry", func(t *testing.T) {
+		pos := BigErfInv(NewBigFloat(0.3, prec), prec)
+		neg := BigErfInv(NewBigFloat(-0.3, prec), prec)
+		sum := new(BigFloat).SetPrec(prec).Add(pos, neg)
+		if sum.Abs(sum).Cmp(tolerance) > 0 {
+			t.Errorf("BigErfInv(0.3) + BigErfInv(-0.3) = %s, want 0", sum.Text('g', 10))
+		}
+	})
+
+	t.Run("special_cases", func(t *testing.T) {
+		if got := BigErfInv(NewBigFloat(0.0, prec), prec); got.Sign() != 0 {
+			t.Errorf("BigErfInv(0) = %s, want 0", got.Text('g', 10))
+		}
+		if got := BigErfInv(NewBigFloat(1.0, prec), prec); !got.IsInf() || got.Sign() < 0 {
+			t.Errorf("BigErfInv(1) = %s, want +Inf", got.Text('g', 10))
+		}
+		if got := BigErfInv(NewBigFloat(-1.0, prec), prec); !got.IsInf() || got.Sign() > 0 {
+			t.Errorf("BigErfInv(-1) = %s, want -Inf", got.Text('g', 10))
+		}
+		// |x| > 1 returns the NaN sentinel
+		nan := NewBigFloat(math.NaN(), prec)
+		for _, v := range []float64{1.5, -2.0} {
+			if got := BigErfInv(NewBigFloat(v, prec), prec); got.IsInf() || got.Cmp(nan) != 0 {
+				t.Errorf("BigErfInv(%g) = %s, want NaN sentinel", v, got.Text('g', 10))
+			}
+		}
+	})
+}
+
+func TestBigErfSeriesAccuracy(t *testing.T) {
+	prec := uint(256)
+
+	// erf(1) to 50 digits
+	want, _ := NewBigFloatFromString("0.84270079294971486934122063508260925929606699796630", prec)
+	tolerance, _ := NewBigFloatFromString("1e-49", prec)
+	diff := new(BigFloat).SetPrec(prec).Sub(BigErf(NewBigFloat(1.0, prec), prec), want)
+	if diff.Abs(diff).Cmp(tolerance) > 0 {
+		t.Errorf("BigErf(1) differs from reference by %s", diff.Text('g', 10))
+	}
+
+	// Agreement with math.Erf/Erfc across the series and asymptotic ranges
+	for _, v := range []float64{0.1, 0.5, 0.9, 1.5, 2.5, 4.0, 6.0} {
+		x := NewBigFloat(v, prec)
+		gotErf, _ := BigErf(x, prec).Float64()
+		if math.Abs(gotErf-math.Erf(v)) > 1e-15 {
+			t.Errorf("BigErf(%g) = %.17g, math.Erf = %.17g", v, gotErf, math.Erf(v))
+		}
+		gotErfc, _ := BigErfc(x, prec).Float64()
+		if math.Abs(gotErfc-math.Erfc(v)) > 1e-14*math.Erfc(v) {
+			t.Errorf("BigErfc(%g) = %.17g, math.Erfc = %.17g", v, gotErfc, math.Erfc(v))
+		}
+	}
+}
+
+func TestBigLogGamma(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-50", prec)
+
+	within := func(t *testing.T, label string, got, want *BigFloat) {
+		t.Helper()
+		diff := new(BigFloat).SetPrec(prec).Sub(got, want)
+		if diff.Abs(diff).Cmp(tolerance) > 0 {
+			t.Errorf("%s = %s, want %s", label, got.Text('g', 55), want.Text('g', 55))
+		}
+	}
+
+	// ln Γ(n) = ln((n-1)!)
+	for _, n := range []int{1, 2, 5, 20, 100} {
+		fact := NewBigFloat(1.0, prec)
+		for k := 2; k < n; k++ {
+			fact.Mul(fact, NewBigFloat(float64(k), prec))
+		}
+		within(t, "ln Γ(n)", BigLogGamma(NewBigFloat(float64(n), prec), prec), BigLog(fact, prec))
+	}
+
+	// ln Γ(1/2) = ½ ln π
+	halfLnPi := BigLog(BigPI(prec), prec)
+	halfLnPi.Quo(halfLnPi, NewBigFloat(2.0, prec))
+	within(t, "ln Γ(1/2)", BigLogGamma(NewBigFloat(0.5, prec), prec), halfLnPi)
+
+	// |Γ(-1/2)| = 2√π
+	within(t, "ln|Γ(-1/2)|", BigLogGamma(NewBigFloat(-0.5, prec), prec),
+		new(BigFloat).SetPrec(prec).Add(halfLnPi, BigLog(NewBigFloat(2.0, prec), prec)))
+
+	for _, v := range []float64{0, -2} {
+		if got := BigLogGamma(NewBigFloat(v, prec), prec); !got.IsInf() {
+			t.Errorf("BigLogGamma(%g) = %s, want +Inf", v, got.Text('g', 10))
+		}
+	}
+}
+
+func TestBigBeta(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-50", prec)
+
+	within := func(t *testing.T, label string, got, want *BigFloat) {
+		t.Helper()
+		diff := new(BigFloat).SetPrec(prec).Sub(got, want)
+		if diff.Abs(diff).Cmp(tolerance) > 0 {
+			t.Errorf("%s = %s, want %s", label, got.Text('g', 55), want.Text('g', 55))
+		}
+	}
+	ratio := func(num, den float64) *BigFloat {
+		return new(BigFloat).SetPrec(prec).Quo(NewBigFloat(num, prec), NewBigFloat(den, prec))
+	}
+
+	t.Run("known_values", func(t *testing.T) {
+		within(t, "B(2,3)", BigBeta(NewBigFloat(2, prec), NewBigFloat(3, prec), prec), ratio(1, 12))
+		within(t, "B(1,1)", BigBeta(NewBigFloat(1, prec), NewBigFloat(1, prec), prec), ratio(1, 1))
+		within(t, "B(1/2,1/2)", BigBeta(NewBigFloat(0.5, prec), NewBigFloat(0.5, prec), prec), BigPI(prec))
+		// B(-1/2, 1) = Γ(-1/2)Γ(1)/Γ(1/2) = -2
+		within(t, "B(-1/2,1)", BigBeta(NewBigFloat(-0.5, prec), NewBigFloat(1, prec), prec), ratio(-2, 1))
+	})
+
+	t.Run("symmetry", func(t *testing.T) {
+		for _, pair := range [][2]float64{{2.5, 7.25}, {0.3, 11}, {-1.5, 4.2}} {
+			a, b := NewBigFloat(pair[0], prec), NewBigFloat(pair[1], prec)
+			within(t, "B(a,b) - B(b,a)", BigBeta(a, b, prec), BigBeta(b, a, prec))
+		}
+	})
+
+	t.Run("log_beta_large_arguments", func(t *testing.T) {
+		// B(500, 500) underflows float64 but its logarithm is ordinary
+		a := NewBigFloat(500, prec)
+		logBeta := BigLogBeta(a, a, prec)
+		want := new(BigFloat).SetPrec(prec).Mul(NewBigFloat(2, prec), BigLogGamma(a, prec))
+		want.Sub(want, BigLogGamma(NewBigFloat(1000, prec), prec))
+		within(t, "ln B(500,500)", logBeta, want)
+		if got, _ := logBeta.Float64(); got > -690 || got < -700 {
+			t.Errorf("ln B(500,500) = %g, want about -694.2", got)
+		}
+	})
+
+	t.Run("poles", func(t *testing.T) {
+		if got := BigBeta(NewBigFloat(-1, prec), NewBigFloat(2.5, prec), prec); !got.IsInf() {
+			t.Errorf("BigBeta(-1, 2.5) = %s, want +Inf", got.Text('g', 10))
+		}
+		// a+b = 0 is a pole of Γ(a+b), so B = 0
+		if got := BigBeta(NewBigFloat(0.5, prec), NewBigFloat(-0.5, prec), prec); got.Sign() != 0 {
+			t.Errorf("BigBeta(0.5, -0.5) = %s, want 0", got.Text('g', 10))
+		}
+		if got := BigLogBeta(NewBigFloat(0.5, prec), NewBigFloat(-0.5, prec), prec); !got.IsInf() || got.Sign() > 0 {
+			t.Errorf("BigLogBeta(0.5, -0.5) = %s, want -Inf", got.Text('g', 10))
+		}
+	})
+}
+
+func TestBigIncompleteGamma(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-40", prec)
+
+	within := func(t *testing.T, label string, got, want *BigFloat) {
+		t.Helper()
+		diff := new(BigFloat).SetPrec(prec).Sub(got, want)
+		if diff.Abs(diff).Cmp(tolerance) > 0 {
+			t.Errorf("%s = %s, want %s", label, got.Text('g', 45), want.Text('g', 45))
+		}
+	}
+
+	t.Run("complement", func(t *testing.T) {
+		// Covers both the series (x < a+1) and continued fraction branches
+		for _, pair := range [][2]float64{{0.5, 0.1}, {1, 1}, {2.5, 3}, {3, 10}, {10, 5}, {10, 20}, {50, 49}} {
+			a, x := NewBigFloat(pair[0], prec), NewBigFloat(pair[1], prec)
+			sum := new(BigFloat).SetPrec(prec).Add(BigGammaP(a, x, prec), BigGammaQ(a, x, prec))
+			within(t, "P + Q", sum, NewBigFloat(1.0, prec))
+		}
+	})
+
+	t.Run("exponential", func(t *testing.T) {
+		// P(1, x) = 1 - e^(-x), Q(1, x) = e^(-x)
+		for _, v := range []float64{0.25, 1.5, 8} {
+			x := NewBigFloat(v, prec)
+			q := BigExp(new(BigFloat).SetPrec(prec).Neg(x), prec)
+			within(t, "Q(1,x)", BigGammaQ(NewBigFloat(1.0, prec), x, prec), q)
+			within(t, "P(1,x)", BigGammaP(NewBigFloat(1.0, prec), x, prec), new(BigFloat).SetPrec(prec).Sub(NewBigFloat(1.0, prec), q))
+		}
+	})
+
+	t.Run("erf_relation", func(t *testing.T) {
+		// P(1/2, x) = erf(√x)
+		for _, v := range []float64{0.3, 2, 6} {
+			x := NewBigFloat(v, prec)
+			within(t, "P(1/2,x)", BigGammaP(NewBigFloat(0.5, prec), x, prec), BigErf(BigSqrt(x, prec), prec))
+		}
+	})
+
+	t.Run("chi_squared_cdf", func(t *testing.T) {
+		// χ² CDF with k degrees of freedom is P(k/2, x/2); math has no gammainc,
+		// so compare against the closed form for k = 2 and standard table values
+		got, _ := BigGammaP(NewBigFloat(1.0, prec), NewBigFloat(3.0/2, prec), prec).Float64()
+		if math.Abs(got-(1-math.Exp(-1.5))) > 1e-15 {
+			t.Errorf("χ²₂ CDF(3) = %.17g, want %.17g", got, 1-math.Exp(-1.5))
+		}
+		// χ²₁ CDF(3.841458820694124) = 0.95
+		got, _ = BigGammaP(NewBigFloat(0.5, prec), NewBigFloat(3.841458820694124/2, prec), prec).Float64()
+		if math.Abs(got-0.95) > 1e-14 {
+			t.Errorf("χ²₁ CDF(3.8415) = %.17g, want 0.95", got)
+		}
+	})
+
+	t.Run("boundaries", func(t *testing.T) {
+		a := NewBigFloat(2.5, prec)
+		zero := NewBigFloat(0.0, prec)
+		if p := BigGammaP(a, zero, prec); p.Sign() != 0 {
+			t.Errorf("P(a, 0) = %s, want 0", p.Text('g', 10))
+		}
+		if q := BigGammaQ(a, zero, prec); !BigEqualFloat64(q, 1.0) {
+			t.Errorf("Q(a, 0) = %s, want 1", q.Text('g', 10))
+		}
+		// Invalid a returns the NaN sentinel
+		nan := NewBigFloat(math.NaN(), prec)
+		if p := BigGammaP(NewBigFloat(-1.0, prec), NewBigFloat(1.0, prec), prec); p.Cmp(nan) != 0 {
+			t.Errorf("P(-1, 1) = %s, want NaN sentinel", p.Text('g', 10))
+		}
+	})
+}
+
+func TestBigZeta(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-40", prec)
+
+	within := func(t *testing.T, label string, got, want *BigFloat) {
+		t.Helper()
+		diff := new(BigFloat).SetPrec(prec).Sub(got, want)
+		if diff.Abs(diff).Cmp(tolerance) > 0 {
+			t.Errorf("%s = %s, want %s", label, got.Text('g', 45), want.Text('g', 45))
+		}
+	}
+	parse := func(s string) *BigFloat {
+		v, _ := NewBigFloatFromString(s, prec)
+		return v
+	}
+
+	t.Run("even_closed_forms", func(t *testing.T) {
+		pi2 := new(BigFloat).SetPrec(prec).Mul(BigPI(prec), BigPI(prec))
+		want2 := new(BigFloat).SetPrec(prec).Quo(pi2, NewBigFloat(6.0, prec))
+		within(t, "ζ(2)", BigZeta(NewBigFloat(2.0, prec), prec), want2)
+
+		pi4 := new(BigFloat).SetPrec(prec).Mul(pi2, pi2)
+		want4 := new(BigFloat).SetPrec(prec).Quo(pi4, NewBigFloat(90.0, prec))
+		within(t, "ζ(4)", BigZeta(NewBigFloat(4.0, prec), prec), want4)
+	})
+
+	t.Run("reference_values", func(t *testing.T) {
+		within(t, "ζ(3)", BigZeta(NewBigFloat(3.0, prec), prec), parse("1.2020569031595942853997381615114499907649862923405"))
+		within(t, "ζ(1/2)", BigZeta(NewBigFloat(0.5, prec), prec), parse("-1.4603545088095868128894991525152980124672293310126"))
+		within(t, "ζ(0)", BigZeta(NewBigFloat(0.0, prec), prec), NewBigFloat(-0.5, prec))
+	})
+
+	t.Run("functional_equation", func(t *testing.T) {
+		// ζ(-1) = -1/12, ζ(-3) = 1/120
+		within(t, "ζ(-1)", BigZeta(NewBigFloat(-1.0, prec), prec),
+			new(BigFloat).SetPrec(prec).Quo(NewBigFloat(-1.0, prec), NewBigFloat(12.0, prec)))
+		within(t, "ζ(-3)", BigZeta(NewBigFloat(-3.0, prec), prec),
+			new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1.0, prec), NewBigFloat(120.0, prec)))
+		for _, v := range []float64{-2, -4, -10} {
+			if got := BigZeta(NewBigFloat(v, prec), prec); got.Sign() != 0 {
+				t.Errorf("BigZeta(%g) = %s, want trivial zero", v, got.Text('g', 10))
+			}
+		}
+	})
+
+	t.Run("pole", func(t *testing.T) {
+		if got := BigZeta(NewBigFloat(1.0, prec), prec); !got.IsInf() || got.Sign() < 0 {
+			t.Errorf("BigZeta(1) = %s, want +Inf", got.Text('g', 10))
+		}
+	})
+}
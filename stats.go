@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"errors"
+	"fmt"
+)
+
+// statsPrec returns prec, or the precision of the first value if prec is 0
+func statsPrec(xs []*BigFloat, prec uint) uint {
+	if prec == 0 {
+		return xs[0].Prec()
+	}
+	return prec
+}
+
+// statsCheckFinite returns an error naming the first infinite value in xs
+func statsCheckFinite(xs []*BigFloat, what string) error {
+	for i, x := range xs {
+		if x.IsInf() {
+			return fmt.Errorf("%s is undefined with an infinite value at index %d", what, i)
+		}
+	}
+	return nil
+}
+
+// bigMeanWork returns the mean of a non-empty slice at workPrec
+func bigMeanWork(xs []*BigFloat, workPrec uint) *BigFloat {
+	mean := BigFloatSum(xs, workPrec)
+	return mean.Quo(mean, NewBigFloat(float64(len(xs)), workPrec))
+}
+
+// bigVarianceWork returns the sample variance of a non-empty slice at workPrec
+// It uses the two-pass formula Σ(x - mean)²/(n-1), which avoids the
+// cancellation of the one-pass Σx² - n·mean² form.
+func bigVarianceWork(xs []*BigFloat, workPrec uint) *BigFloat {
+	if len(xs) == 1 {
+		return NewBigFloat(0.0, workPrec)
+	}
+
+	mean := bigMeanWork(xs, workPrec)
+	sum := NewBigCompensatedSum(workPrec)
+	d := new(BigFloat).SetPrec(workPrec)
+	for _, x := range xs {
+		d.Sub(x, mean)
+		sum.Add(new(BigFloat).SetPrec(workPrec).Mul(d, d))
+	}
+
+	variance := sum.Sum()
+	return variance.Quo(variance, NewBigFloat(float64(len(xs)-1), workPrec))
+}
+
+// BigMean returns the arithmetic mean of xs
+// The values are summed with compensated summation. Returns an error for an
+// empty slice or an infinite value.
+func BigMean(xs []*BigFloat, prec uint) (*BigFloat, error) {
+	if len(xs) == 0 {
+		return nil, errors.New("mean of an empty slice is undefined")
+	}
+	if err := statsCheckFinite(xs, "mean"); err != nil {
+		return nil, err
+	}
+	prec = statsPrec(xs, prec)
+
+	return new(BigFloat).SetPrec(prec).Set(bigMeanWork(xs, prec+32)), nil
+}
+
+// BigVariance returns the sample variance of xs, Σ(x - mean)²/(n-1)
+// A single value has variance 0. Returns an error for an empty slice or an
+// infinite value.
+func BigVariance(xs []*BigFloat, prec uint) (*BigFloat, error) {
+	if len(xs) == 0 {
+		return nil, errors.New("variance of an empty slice is undefined")
+	}
+	if err := statsCheckFinite(xs, "variance"); err != nil {
+		return nil, err
+	}
+	prec = statsPrec(xs, prec)
+
+	return new(BigFloat).SetPrec(prec).Set(bigVarianceWork(xs, prec+32)), nil
+}
+
+// BigStdDev returns the sample standard deviation of xs, the square root of
+// BigVariance. Returns an error for an empty slice or an infinite value.
+func BigStdDev(xs []*BigFloat, prec uint) (*BigFloat, error) {
+	if len(xs) == 0 {
+		return nil, errors.New("standard deviation of an empty slice is undefined")
+	}
+	if err := statsCheckFinite(xs, "standard deviation"); err != nil {
+		return nil, err
+	}
+	prec = statsPrec(xs, prec)
+
+	return BigSqrt(bigVarianceWork(xs, prec+32), prec), nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"testing"
+)
+
+func TestBigStats(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+
+	floats := func(vals ...float64) []*BigFloat {
+		xs := make([]*BigFloat, len(vals))
+		for i, v := range vals {
+			xs[i] = NewBigFloat(v, prec)
+		}
+		return xs
+	}
+	parse := func(vals ...string) []*BigFloat {
+		xs := make([]*BigFloat, len(vals))
+		for i, v := range vals {
+			xs[i], _ = NewBigFloatFromString(v, prec)
+		}
+		return xs
+	}
+
+	tests := []struct {
+		name     string
+		xs       []*BigFloat
+		mean     string
+		variance string
+	}{
+		{"single", floats(3.5), "3.5", "0"},
+		{"integers", floats(2, 4, 4, 4, 5, 5, 7, 9), "5", "4.571428571428571428571428571428571428571428571428571"},
+		{"symmetric", floats(-1, 0, 1), "0", "1"},
+		{"offset", parse("100000000000000000001", "100000000000000000002", "100000000000000000003"), "100000000000000000002", "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mean, err := BigMean(tt.xs, prec)
+			if err != nil {
+				t.Fatalf("BigMean: %v", err)
+			}
+			want, _ := NewBigFloatFromString(tt.mean, prec)
+			if !BigFloatEqual(mean, want, tol) {
+				t.Errorf("BigMean = %s, want %s", mean.Text('g', 50), tt.mean)
+			}
+
+			variance, err := BigVariance(tt.xs, prec)
+			if err != nil {
+				t.Fatalf("BigVariance: %v", err)
+			}
+			want, _ = NewBigFloatFromString(tt.variance, prec)
+			if !BigFloatEqual(variance, want, tol) {
+				t.Errorf("BigVariance = %s, want %s", variance.Text('g', 50), tt.variance)
+			}
+
+			stddev, err := BigStdDev(tt.xs, prec)
+			if err != nil {
+				t.Fatalf("BigStdDev: %v", err)
+			}
+			if !BigFloatEqual(stddev, BigSqrt(variance, prec), tol) {
+				t.Errorf("BigStdDev = %s, want √variance = %s", stddev.Text('g', 50), BigSqrt(variance, prec).Text('g', 50))
+			}
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		if _, err := BigMean(nil, prec); err == nil {
+			t.Error("BigMean of an empty slice should return an error")
+		}
+		if _, err := BigVariance(nil, prec); err == nil {
+			t.Error("BigVariance of an empty slice should return an error")
+		}
+		if _, err := BigStdDev(nil, prec); err == nil {
+			t.Error("BigStdDev of an empty slice should return an error")
+		}
+	})
+
+	t.Run("infinite", func(t *testing.T) {
+		for _, xs := range [][]*BigFloat{
+			{new(BigFloat).SetInf(false)},
+			{NewBigFloat(1.0, prec), new(BigFloat).SetInf(false), NewBigFloat(2.0, prec)},
+			{NewBigFloat(1.0, prec), new(BigFloat).SetInf(true)},
+		} {
+			if _, err := BigMean(xs, prec); err == nil {
+				t.Errorf("BigMean of %d values with an infinity should return an error", len(xs))
+			}
+			if _, err := BigVariance(xs, prec); err == nil {
+				t.Errorf("BigVariance of %d values with an infinity should return an error", len(xs))
+			}
+			if _, err := BigStdDev(xs, 0); err == nil {
+				t.Errorf("BigStdDev of %d values with an infinity should return an error", len(xs))
+			}
+		}
+	})
+}
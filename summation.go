@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+// BigCompensatedSum accumulates a stream of BigFloats at a fixed precision using
+// the Kahan–Babuška–Neumaier algorithm. The rounding error of every addition is
+// carried in a separate compensation term, so cancellation between large terms
+// does not lose the contribution of small ones.
+type BigCompensatedSum struct {
+	sum  *BigFloat
+	comp *BigFloat
+	temp *BigFloat
+	prec uint
+}
+
+// NewBigCompensatedSum creates an empty compensated sum with the given precision
+func NewBigCompensatedSum(prec uint) *BigCompensatedSum {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+	return &BigCompensatedSum{
+		sum:  NewBigFloat(0.0, prec),
+		comp: NewBigFloat(0.0, prec),
+		temp: NewBigFloat(0.0, prec),
+		prec: prec,
+	}
+}
+
+// Add adds x to the running sum
+// Once the sum is infinite it stays infinite; adding infinities of opposite
+// sign panics with big.ErrNaN, as BigFloat.Add does.
+func (s *BigCompensatedSum) Add(x *BigFloat) {
+	// t = sum + x
+	t := new(BigFloat).SetPrec(s.prec).Add(s.sum, x)
+
+	// An infinite total has no rounding error to recover, and the
+	// compensation below would compute Inf - Inf
+	if t.IsInf() {
+		s.sum = t
+		return
+	}
+
+	// Recover the low-order bits lost in t from whichever operand is smaller
+	if new(BigFloat).Abs(s.sum).Cmp(new(BigFloat).Abs(x)) >= 0 {
+		// comp += (sum - t) + x
+		s.temp.Sub(s.sum, t)
+		s.temp.Add(s.temp, x)
+	} else {
+		// comp += (x - t) + sum
+		s.temp.Sub(x, t)
+		s.temp.Add(s.temp, s.sum)
+	}
+	s.comp.Add(s.comp, s.temp)
+
+	s.sum = t
+}
+
+// Sum returns the compensated total of all values added so far
+func (s *BigCompensatedSum) Sum() *BigFloat {
+	if s.sum.IsInf() {
+		return new(BigFloat).SetPrec(s.prec).Set(s.sum)
+	}
+	return new(BigFloat).SetPrec(s.prec).Add(s.sum, s.comp)
+}
+
+// BigFloatSum returns the sum of xs using Neumaier compensated summation at
+// the given precision (see BigCompensatedSum). An empty slice sums to 0
+// and an infinite element makes the sum infinite.
+func BigFloatSum(xs []*BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		if len(xs) > 0 {
+			prec = xs[0].Prec()
+		} else {
+			prec = DefaultPrecision
+		}
+	}
+
+	s := NewBigCompensatedSum(prec)
+	for _, x := range xs {
+		s.Add(x)
+	}
+	return s.Sum()
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"testing"
+)
+
+func TestBigCompensatedSum(t *testing.T) {
+	prec := uint(64)
+	big60, _ := NewBigFloatFromString("1e60", prec)
+	negBig60 := new(BigFloat).SetPrec(prec).Neg(big60)
+	one := NewBigFloat(1.0, prec)
+
+	t.Run("cancellation_stream", func(t *testing.T) {
+		stream := []*BigFloat{}
+		for i := 0; i < 10; i++ {
+			stream = append(stream, big60, one, negBig60, one)
+		}
+
+		naive := NewBigFloat(0.0, prec)
+		sum := NewBigCompensatedSum(prec)
+		for _, x := range stream {
+			naive.Add(naive, x)
+			sum.Add(x)
+		}
+
+		// Every 1 added next to 1e60 is lost by a 64-bit running total
+		if naive.Cmp(NewBigFloat(20.0, prec)) == 0 {
+			t.Fatalf("naive sum unexpectedly exact; test does not exercise cancellation")
+		}
+		if got := sum.Sum(); got.Cmp(NewBigFloat(20.0, prec)) != 0 {
+			t.Errorf("compensated sum = %s, want 20 (naive sum = %s)", got.Text('g', 20), naive.Text('g', 20))
+		}
+	})
+
+	t.Run("small_first", func(t *testing.T) {
+		// The compensation branch for |x| > |sum|
+		sum := NewBigCompensatedSum(prec)
+		for _, x := range []*BigFloat{one, big60, one, negBig60} {
+			sum.Add(x)
+		}
+		if got := sum.Sum(); got.Cmp(NewBigFloat(2.0, prec)) != 0 {
+			t.Errorf("compensated sum = %s, want 2", got.Text('g', 20))
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		sum := NewBigCompensatedSum(0)
+		got := sum.Sum()
+		if got.Sign() != 0 {
+			t.Errorf("empty sum = %s, want 0", got.Text('g', 10))
+		}
+		if got.Prec() != DefaultPrecision {
+			t.Errorf("empty sum precision = %d, want %d", got.Prec(), DefaultPrecision)
+		}
+	})
+
+	t.Run("exact_values", func(t *testing.T) {
+		sum := NewBigCompensatedSum(256)
+		for i := 1; i <= 100; i++ {
+			sum.Add(NewBigFloat(float64(i), 256))
+		}
+		if got := sum.Sum(); got.Cmp(NewBigFloat(5050.0, 256)) != 0 {
+			t.Errorf("sum 1..100 = %s, want 5050", got.Text('g', 20))
+		}
+	})
+
+	t.Run("infinite_term", func(t *testing.T) {
+		posInf := new(BigFloat).SetInf(false)
+		negInf := new(BigFloat).SetInf(true)
+
+		tests := []struct {
+			name  string
+			terms []*BigFloat
+			want  *BigFloat
+		}{
+			{"inf_after_finite", []*BigFloat{one, posInf}, posInf},
+			{"finite_after_inf", []*BigFloat{posInf, one, big60}, posInf},
+			{"neg_inf_after_cancellation", []*BigFloat{big60, one, negInf, negBig60}, negInf},
+			{"same_sign_infinities", []*BigFloat{negInf, one, negInf}, negInf},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				sum := NewBigCompensatedSum(prec)
+				for _, x := range tt.terms {
+					sum.Add(x)
+				}
+				if got := sum.Sum(); got.Cmp(tt.want) != 0 {
+					t.Errorf("compensated sum = %s, want %s", got.Text('g', 10), tt.want.Text('g', 10))
+				}
+			})
+		}
+	})
+}
+
+func TestBigFloatSum(t *testing.T) {
+	prec := uint(53)
+	big30, _ := NewBigFloatFromString("1e30", prec)
+	negBig30 := new(BigFloat).SetPrec(prec).Neg(big30)
+	one := NewBigFloat(1.0, prec)
+	xs := []*BigFloat{big30, one, negBig30, one}
+
+	naive := NewBigFloat(0.0, prec)
+	for _, x := range xs {
+		naive.Add(naive, x)
+	}
+	if naive.Cmp(NewBigFloat(2.0, prec)) == 0 {
+		t.Fatalf("naive sum unexpectedly exact; test does not exercise cancellation")
+	}
+
+	got := BigFloatSum(xs, prec)
+	if got.Cmp(NewBigFloat(2.0, prec)) != 0 {
+		t.Errorf("BigFloatSum([1e30, 1, -1e30, 1]) = %s, want 2 (naive sum = %s)", got.Text('g', 20), naive.Text('g', 20))
+	}
+	if got.Prec() != prec {
+		t.Errorf("BigFloatSum precision = %d, want %d", got.Prec(), prec)
+	}
+
+	if empty := BigFloatSum(nil, 0); empty.Sign() != 0 || empty.Prec() != DefaultPrecision {
+		t.Errorf("BigFloatSum(nil) = %s at prec %d, want 0 at %d", empty.Text('g', 10), empty.Prec(), DefaultPrecision)
+	}
+
+	// An infinite element makes the whole sum infinite
+	posInf := new(BigFloat).SetInf(false)
+	if got := BigFloatSum([]*BigFloat{one, posInf}, 128); !got.IsInf() || got.Sign() <= 0 || got.Prec() != 128 {
+		t.Errorf("BigFloatSum([1, +Inf]) = %s at prec %d, want +Inf at 128", got.Text('g', 10), got.Prec())
+	}
+	negInf := new(BigFloat).SetInf(true)
+	if got := BigFloatSum([]*BigFloat{big30, negInf, one, negBig30}, 0); !got.IsInf() || got.Sign() >= 0 {
+		t.Errorf("BigFloatSum([1e30, -Inf, 1, -1e30]) = %s, want -Inf", got.Text('g', 10))
+	}
+}
@@ -30,6 +30,52 @@ func BigCosRounded(x *BigFloat, prec uint, mode RoundingMode) (result *BigFloat,
 	return Round(res, prec, mode)
 }
 
+// BigSinCos computes sin(x) and cos(x) together
+// The angle is reduced once and only the smaller of the two is summed as a
+// Taylor series; the other follows from sin² + cos² = 1, which is well
+// conditioned because it is at least 1/√2 in magnitude.
+func BigSinCos(x *BigFloat, prec uint) (sin, cos *BigFloat) {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+
+	workPrec := prec + 16
+	r := normalizeAngle(x, workPrec) // r in [-π, π]
+
+	absR := new(BigFloat).SetPrec(workPrec).Abs(r)
+	quarterPi := BigPI(workPrec)
+	quarterPi.SetMantExp(quarterPi, -2)
+	threeQuarterPi := new(BigFloat).SetPrec(workPrec).Mul(quarterPi, NewBigFloat(3.0, workPrec))
+
+	one := NewBigFloat(1.0, workPrec)
+	complement := func(v *BigFloat) *BigFloat {
+		// √(1 - v²)
+		w := new(BigFloat).SetPrec(workPrec).Mul(v, v)
+		w.Sub(one, w)
+		if w.Sign() <= 0 {
+			return NewBigFloat(0.0, workPrec)
+		}
+		return w.Sqrt(w)
+	}
+
+	var s, c *BigFloat
+	if absR.Cmp(quarterPi) <= 0 || absR.Cmp(threeQuarterPi) >= 0 {
+		s = getDispatcher().BigSinImpl(r, workPrec)
+		c = complement(s)
+		if absR.Cmp(BigHalfPI(workPrec)) > 0 {
+			c.Neg(c)
+		}
+	} else {
+		c = getDispatcher().BigCosImpl(r, workPrec)
+		s = complement(c)
+		if r.Sign() < 0 {
+			s.Neg(s)
+		}
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(s), new(BigFloat).SetPrec(prec).Set(c)
+}
+
 // BigTan computes tan(x) = sin(x) / cos(x)
 func BigTan(x *BigFloat, prec uint) *BigFloat {
 	return getDispatcher().BigTanImpl(x, prec)
@@ -92,3 +138,65 @@ func BigAcosRounded(x *BigFloat, prec uint, mode RoundingMode) (result *BigFloat
 	res := BigAcos(x, workPrec)
 	return Round(res, prec, mode)
 }
+
+// BigSinc computes the unnormalized cardinal sine sin(x)/x
+// sinc(0) = 1 exactly. For |x| < 1 the Taylor series
+// 1 - x²/3! + x⁴/5! - ... is summed directly, avoiding the 0/0 form near zero.
+func BigSinc(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	if x.Sign() == 0 {
+		return NewBigFloat(1.0, prec)
+	}
+	if x.IsInf() {
+		return NewBigFloat(0.0, prec)
+	}
+
+	workPrec := prec + 32
+	absX := new(BigFloat).SetPrec(workPrec).Abs(x)
+	if absX.Cmp(NewBigFloat(1.0, workPrec)) >= 0 {
+		s := BigSin(x, workPrec)
+		s.Quo(s, x)
+		return new(BigFloat).SetPrec(prec).Set(s)
+	}
+
+	// Terms alternate and decrease from 1, so stop once one drops below 2^-workPrec
+	x2 := new(BigFloat).SetPrec(workPrec).Mul(x, x)
+	sum := NewBigFloat(1.0, workPrec)
+	term := NewBigFloat(1.0, workPrec)
+	threshold := new(BigFloat).SetMantExp(NewBigFloat(1.0, workPrec), -int(workPrec))
+	for k := 1; k < 1000; k++ {
+		term.Mul(term, x2)
+		term.Quo(term, NewBigFloat(float64((2*k)*(2*k+1)), workPrec))
+		term.Neg(term)
+		sum.Add(sum, term)
+		if new(BigFloat).Abs(term).Cmp(threshold) < 0 {
+			break
+		}
+	}
+
+	return new(BigFloat).SetPrec(prec).Set(sum)
+}
+
+// BigSincNormalized computes the normalized cardinal sine sin(πx)/(πx)
+// It is 1 at x = 0 and exactly 0 at every other integer.
+func BigSincNormalized(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	if x.Sign() == 0 {
+		return NewBigFloat(1.0, prec)
+	}
+	if x.IsInt() {
+		return NewBigFloat(0.0, prec)
+	}
+
+	// πx needs extra bits for the integer part of x to keep sin(πx) accurate
+	workPrec := prec + 32
+	if exp := x.MantExp(nil); exp > 0 {
+		workPrec += uint(exp)
+	}
+	px := new(BigFloat).SetPrec(workPrec).Mul(BigPI(workPrec), x)
+	return BigSinc(px, prec)
+}
@@ -26,30 +26,31 @@ func bigSinGeneric(x *BigFloat, prec uint) *BigFloat {
 	// We use a slightly higher working precision for intermediate steps
 	workPrec := prec + 16
 
-	result := new(BigFloat).SetPrec(workPrec)
-	term := new(BigFloat).SetPrec(workPrec).Set(x) // First term is x
+	// The series temporaries come from the pool and never escape
+	result := getScratch(workPrec)
+	term := getScratch(workPrec).Set(x) // First term is x
 	result.Set(term)
 
-	xSquared := new(BigFloat).SetPrec(workPrec)
+	xSquared := getScratch(workPrec)
 	xSquared.Mul(x, x)
 
 	// Convergence threshold
-	threshold := new(BigFloat).SetPrec(workPrec).SetMantExp(NewBigFloat(1.0, workPrec), -int(prec))
+	threshold := getScratch(workPrec).SetMantExp(NewBigFloat(1.0, workPrec), -int(prec))
+	denominator := getScratch(workPrec)
+	absTerm := getScratch(workPrec)
+	defer putScratch(result, term, xSquared, threshold, denominator, absTerm)
 
 	for n := 1; n < 200; n++ {
 		// term = term * (-x²) / ((2n)(2n+1))
-		denominator1 := new(BigFloat).SetPrec(workPrec).SetInt64(int64(2 * n))
-		denominator2 := new(BigFloat).SetPrec(workPrec).SetInt64(int64(2*n + 1))
-
 		term.Mul(term, xSquared)
 		term.Neg(term)
-		term.Quo(term, denominator1)
-		term.Quo(term, denominator2)
+		term.Quo(term, denominator.SetInt64(int64(2*n)))
+		term.Quo(term, denominator.SetInt64(int64(2*n+1)))
 
 		result.Add(result, term)
 
 		// Check convergence
-		if BigAbs(term, workPrec).Cmp(threshold) < 0 {
+		if absTerm.Abs(term).Cmp(threshold) < 0 {
 			break
 		}
 	}
@@ -70,29 +71,29 @@ func bigCosGeneric(x *BigFloat, prec uint) *BigFloat {
 
 	workPrec := prec + 16
 
-	// Taylor series computation
-	result := NewBigFloat(1.0, workPrec) // First term is 1
-	term := NewBigFloat(1.0, workPrec)
+	// Taylor series computation, with pooled temporaries as in bigSinGeneric
+	result := getScratch(workPrec).SetInt64(1) // First term is 1
+	term := getScratch(workPrec).SetInt64(1)
 
-	xSquared := new(BigFloat).SetPrec(workPrec)
+	xSquared := getScratch(workPrec)
 	xSquared.Mul(x, x)
 
-	threshold := new(BigFloat).SetPrec(workPrec).SetMantExp(NewBigFloat(1.0, workPrec), -int(prec))
+	threshold := getScratch(workPrec).SetMantExp(NewBigFloat(1.0, workPrec), -int(prec))
+	denominator := getScratch(workPrec)
+	absTerm := getScratch(workPrec)
+	defer putScratch(result, term, xSquared, threshold, denominator, absTerm)
 
 	for n := 1; n < 200; n++ {
 		// term = term * (-x²) / ((2n-1)(2n))
-		denominator1 := new(BigFloat).SetPrec(workPrec).SetInt64(int64(2*n - 1))
-		denominator2 := new(BigFloat).SetPrec(workPrec).SetInt64(int64(2 * n))
-
 		term.Mul(term, xSquared)
 		term.Neg(term)
-		term.Quo(term, denominator1)
-		term.Quo(term, denominator2)
+		term.Quo(term, denominator.SetInt64(int64(2*n-1)))
+		term.Quo(term, denominator.SetInt64(int64(2*n)))
 
 		result.Add(result, term)
 
 		// Check convergence
-		if BigAbs(term, workPrec).Cmp(threshold) < 0 {
+		if absTerm.Abs(term).Cmp(threshold) < 0 {
 			break
 		}
 	}
@@ -217,8 +218,8 @@ func bigAtan2Generic(y, x *BigFloat, prec uint) *BigFloat {
 	pi := BigPI(prec)
 	halfPi := BigHalfPI(prec)
 
-	if x.Cmp(zero) == 0 && y.Cmp(zero) == 0 {
-		return NewBigFloat(0.0, prec)
+	if y.Sign() == 0 {
+		return bigAtan2ZeroY(y, x, prec)
 	}
 
 	if x.Cmp(zero) == 0 {
@@ -229,13 +230,6 @@ func bigAtan2Generic(y, x *BigFloat, prec uint) *BigFloat {
 		return result.Neg(result)
 	}
 
-	if y.Cmp(zero) == 0 {
-		if x.Sign() > 0 {
-			return NewBigFloat(0.0, prec)
-		}
-		return new(BigFloat).SetPrec(prec).Set(pi)
-	}
-
 	ratio := new(BigFloat).SetPrec(prec).Quo(y, x)
 	atan := BigAtan(ratio, prec)
 
@@ -250,6 +244,21 @@ func bigAtan2Generic(y, x *BigFloat, prec uint) *BigFloat {
 	return new(BigFloat).SetPrec(prec).Sub(atan, pi)
 }
 
+// bigAtan2ZeroY returns atan2(±0, x) with IEEE 754 signed-zero semantics:
+// ±0 for x > 0 or x = +0, and ±π for x < 0 or x = -0, taking the sign of y
+func bigAtan2ZeroY(y, x *BigFloat, prec uint) *BigFloat {
+	var result *BigFloat
+	if x.Signbit() {
+		result = BigPI(prec)
+	} else {
+		result = NewBigFloat(0.0, prec)
+	}
+	if y.Signbit() {
+		result.Neg(result)
+	}
+	return result
+}
+
 //nolint:unused // Used in dispatch system
 func bigAsinGeneric(x *BigFloat, prec uint) *BigFloat {
 	if prec == 0 {
@@ -324,7 +333,6 @@ func normalizeAngle(x *BigFloat, prec uint) *BigFloat {
 		prec = x.Prec()
 	}
 
-	twoPi := BigTwoPI(prec)
 	pi := BigPI(prec)
 	negPi := new(BigFloat).SetPrec(prec).Neg(pi)
 
@@ -334,12 +342,19 @@ func normalizeAngle(x *BigFloat, prec uint) *BigFloat {
 	}
 
 	// Reduce x modulo 2π using extended precision for intermediate calculation
-	// This minimizes rounding errors in the subtraction
+	// This minimizes rounding errors in the subtraction. The error in 2π is
+	// multiplied by the number of rotations, so π carries the extra bits of x.
+	// The precision is rounded up to a constant cache bucket so that varied
+	// large arguments share one cached π.
 	extPrec := prec + 64
+	if exp := x.MantExp(nil); exp > 0 {
+		extPrec += uint(exp)
+	}
+	extPrec = constantCachePrec(extPrec)
 
 	result := new(BigFloat).SetPrec(extPrec).Set(x)
-	twoPiExt := new(BigFloat).SetPrec(extPrec).Set(twoPi)
-	piExt := new(BigFloat).SetPrec(extPrec).Set(pi)
+	piExt := BigPI(extPrec)
+	twoPiExt := new(BigFloat).SetMantExp(piExt, 1)
 
 	// Number of full rotations
 	nRotations := new(BigFloat).SetPrec(extPrec)
@@ -146,14 +146,9 @@ func bigAtan2Optimized(y, x *BigFloat, prec uint) *BigFloat {
 		prec = y.Prec()
 	}
 
-	zero := NewBigFloat(0.0, prec)
-
 	// Handle special cases
 	if y.Sign() == 0 {
-		if x.Sign() >= 0 {
-			return zero
-		}
-		return BigPI(prec)
+		return bigAtan2ZeroY(y, x, prec)
 	}
 
 	if x.Sign() == 0 {
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+// BigSec computes sec(x) = 1 / cos(x)
+// Returns ±Inf where cos(x) vanishes at the working precision.
+func BigSec(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	one := func(p uint) *BigFloat { return NewBigFloat(1.0, p) }
+	return bigReciprocalTrig(x, prec, one, BigCos)
+}
+
+// BigCsc computes csc(x) = 1 / sin(x)
+// Returns ±Inf at x = 0 (with the sign of the zero) and where sin(x)
+// vanishes at the working precision.
+func BigCsc(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	one := func(p uint) *BigFloat { return NewBigFloat(1.0, p) }
+	return bigReciprocalTrig(x, prec, one, BigSin)
+}
+
+// BigCot computes cot(x) = cos(x) / sin(x)
+// Returns ±Inf at x = 0 (with the sign of the zero) and where sin(x)
+// vanishes at the working precision.
+func BigCot(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	cos := func(p uint) *BigFloat { return BigCos(x, p) }
+	return bigReciprocalTrig(x, prec, cos, BigSin)
+}
+
+// bigReciprocalTrig computes num(p) / den(x, p)
+// Near a zero of den the computed denominator is dominated by rounding error
+// and may have the wrong sign, so the precision is doubled until the
+// denominator is resolved. If it still is not, the result is ±Inf with the
+// sign of the last denominator.
+func bigReciprocalTrig(x *BigFloat, prec uint, num func(p uint) *BigFloat, den func(x *BigFloat, p uint) *BigFloat) *BigFloat {
+	if x.Sign() == 0 {
+		// den is sin for csc and cot, cos for sec
+		d := den(x, prec)
+		if d.Sign() == 0 {
+			return new(BigFloat).SetPrec(prec).SetInf(x.Signbit())
+		}
+		return new(BigFloat).SetPrec(prec).Quo(num(prec), d)
+	}
+
+	var d *BigFloat
+	for workPrec := prec + 32; workPrec <= 4*prec+128; workPrec *= 2 {
+		d = den(x, workPrec)
+		// The absolute error of d is a few ulps of 1 at workPrec
+		if d.Sign() != 0 && d.MantExp(nil) > -int(workPrec)+16 {
+			res := new(BigFloat).SetPrec(workPrec).Quo(num(workPrec), d)
+			return new(BigFloat).SetPrec(prec).Set(res)
+		}
+	}
+
+	return new(BigFloat).SetPrec(prec).SetInf(d.Sign() < 0)
+}
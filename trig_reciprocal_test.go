@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBigReciprocalTrig(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+	quarterPi := new(BigFloat).SetPrec(prec).Quo(BigPI(prec), NewBigFloat(4.0, prec))
+
+	near := func(t *testing.T, name string, got, want *BigFloat) {
+		t.Helper()
+		diff := new(BigFloat).SetPrec(prec).Sub(got, want)
+		if diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("%s = %s, want %s", name, got.Text('g', 45), want.Text('g', 45))
+		}
+	}
+
+	t.Run("special_values", func(t *testing.T) {
+		one := NewBigFloat(1.0, prec)
+		near(t, "BigSec(0)", BigSec(NewBigFloat(0.0, prec), prec), one)
+		near(t, "BigCot(π/4)", BigCot(quarterPi, prec), one)
+		near(t, "BigCsc(π/2)", BigCsc(BigHalfPI(prec), prec), one)
+		near(t, "BigSec(π)", BigSec(BigPI(prec), prec), NewBigFloat(-1.0, prec))
+		near(t, "BigCsc(-π/2)", BigCsc(new(BigFloat).Neg(BigHalfPI(prec)), prec), NewBigFloat(-1.0, prec))
+		near(t, "BigCot(π/2)", BigCot(BigHalfPI(prec), prec), NewBigFloat(0.0, prec))
+	})
+
+	t.Run("zero_poles", func(t *testing.T) {
+		zero := NewBigFloat(0.0, prec)
+		negZero := new(BigFloat).SetPrec(prec).Neg(zero)
+		for name, got := range map[string]*BigFloat{"BigCsc(0)": BigCsc(zero, prec), "BigCot(0)": BigCot(zero, prec)} {
+			if !got.IsInf() || got.Sign() < 0 {
+				t.Errorf("%s = %s, want +Inf", name, got.Text('g', 10))
+			}
+		}
+		if got := BigCot(negZero, prec); !got.IsInf() || got.Sign() > 0 {
+			t.Errorf("BigCot(-0) = %s, want -Inf", got.Text('g', 10))
+		}
+	})
+
+	// Just below and above the poles the result must be huge with the correct sign
+	t.Run("near_poles", func(t *testing.T) {
+		eps, _ := NewBigFloatFromString("1e-30", prec)
+		below := new(BigFloat).SetPrec(prec).Sub(BigHalfPI(prec), eps)
+		above := new(BigFloat).SetPrec(prec).Add(BigHalfPI(prec), eps)
+		piBelow := new(BigFloat).SetPrec(prec).Sub(BigPI(prec), eps)
+
+		tests := []struct {
+			name string
+			got  *BigFloat
+			want float64
+		}{
+			{"BigSec(π/2-ε)", BigSec(below, prec), 1e30},
+			{"BigSec(π/2+ε)", BigSec(above, prec), -1e30},
+			{"BigCsc(π-ε)", BigCsc(piBelow, prec), 1e30},
+			{"BigCot(π-ε)", BigCot(piBelow, prec), -1e30},
+			{"BigCot(ε)", BigCot(eps, prec), 1e30},
+		}
+		for _, tt := range tests {
+			got, _ := tt.got.Float64()
+			if math.Abs(got-tt.want) > 1e-10*math.Abs(tt.want) {
+				t.Errorf("%s = %g, want %g", tt.name, got, tt.want)
+			}
+		}
+	})
+
+	// 1 + cot² = csc² and 1 + tan² = sec²
+	t.Run("identities", func(t *testing.T) {
+		for _, v := range []float64{0.1, 0.7, 1.3, 2.0, -2.5, 4.0, 10.0} {
+			x := NewBigFloat(v, prec)
+			cot := BigCot(x, prec)
+			csc := BigCsc(x, prec)
+			sec := BigSec(x, prec)
+			tan := BigTan(x, prec)
+
+			lhs := new(BigFloat).SetPrec(prec).Mul(cot, cot)
+			lhs.Add(lhs, NewBigFloat(1.0, prec))
+			rhs := new(BigFloat).SetPrec(prec).Mul(csc, csc)
+			lhs.Quo(lhs, rhs)
+			near(t, "(1+cot²)/csc²", lhs, NewBigFloat(1.0, prec))
+
+			lhs = new(BigFloat).SetPrec(prec).Mul(tan, tan)
+			lhs.Add(lhs, NewBigFloat(1.0, prec))
+			rhs = new(BigFloat).SetPrec(prec).Mul(sec, sec)
+			lhs.Quo(lhs, rhs)
+			near(t, "(1+tan²)/sec²", lhs, NewBigFloat(1.0, prec))
+		}
+	})
+}
@@ -4,6 +4,7 @@
 package bigmath
 
 import (
+	"fmt"
 	"math"
 	"testing"
 )
@@ -326,3 +327,129 @@ func TestTrigPrecisionLevels(t *testing.T) {
 		})
 	}
 }
+
+func TestBigSinCos(t *testing.T) {
+	for _, prec := range []uint{128, 256, 512} {
+		tol, _ := NewBigFloatFromString("1e-45", prec)
+		if prec == 128 {
+			tol, _ = NewBigFloatFromString("1e-35", prec)
+		}
+		inputs := []string{"0", "1e-30", "0.5", "-0.7853981633974483", "1.2", "-1.5707963", "2.0", "2.5", "-3.1", "3.14159", "10", "-100", "12345.678"}
+		for _, in := range inputs {
+			x, _ := NewBigFloatFromString(in, prec)
+			sin, cos := BigSinCos(x, prec)
+			if sin.Prec() != prec || cos.Prec() != prec {
+				t.Errorf("BigSinCos(%s) precision = %d, %d; want %d", in, sin.Prec(), cos.Prec(), prec)
+			}
+
+			for _, c := range []struct {
+				name      string
+				got, want *BigFloat
+			}{
+				{"sin", sin, BigSin(x, prec+32)},
+				{"cos", cos, BigCos(x, prec+32)},
+			} {
+				diff := new(BigFloat).SetPrec(prec).Sub(c.got, c.want)
+				if diff.Abs(diff).Cmp(tol) > 0 {
+					t.Errorf("prec %d: BigSinCos(%s) %s = %s, want %s", prec, in, c.name, c.got.Text('g', 50), c.want.Text('g', 50))
+				}
+			}
+		}
+	}
+}
+
+func TestBigSinc(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-70", prec)
+
+	t.Run("zero", func(t *testing.T) {
+		if got := BigSinc(NewBigFloat(0, prec), prec); got.Cmp(NewBigFloat(1, prec)) != 0 {
+			t.Errorf("BigSinc(0) = %s, want 1", got.Text('g', 20))
+		}
+		if got := BigSincNormalized(NewBigFloat(0, prec), prec); got.Cmp(NewBigFloat(1, prec)) != 0 {
+			t.Errorf("BigSincNormalized(0) = %s, want 1", got.Text('g', 20))
+		}
+	})
+
+	t.Run("normalized_integers", func(t *testing.T) {
+		for _, n := range []float64{1, -1, 2, 17} {
+			if got := BigSincNormalized(NewBigFloat(n, prec), prec); got.Sign() != 0 {
+				t.Errorf("BigSincNormalized(%g) = %s, want 0", n, got.Text('g', 20))
+			}
+		}
+	})
+
+	// Both branches agree with sin(x)/x computed at higher precision
+	for _, v := range []float64{1e-30, 1e-5, 0.5, 0.999, 1, 2.5, -7.25} {
+		t.Run(fmt.Sprintf("value_%g", v), func(t *testing.T) {
+			x := NewBigFloat(v, prec)
+			want := BigSin(x, prec+64)
+			want.Quo(want, x)
+			if got := BigSinc(x, prec); !BigFloatEqual(got, want, tol) {
+				t.Errorf("BigSinc(%g) = %s, want %s", v, got.Text('g', 50), want.Text('g', 50))
+			}
+		})
+	}
+
+	t.Run("even", func(t *testing.T) {
+		for _, v := range []float64{1e-20, 0.25, 0.75, 3, 100.5} {
+			x := NewBigFloat(v, prec)
+			neg := new(BigFloat).Neg(x)
+			if !BigFloatEqual(BigSinc(x, prec), BigSinc(neg, prec), tol) {
+				t.Errorf("BigSinc(%g) != BigSinc(%g)", v, -v)
+			}
+			if !BigFloatEqual(BigSincNormalized(x, prec), BigSincNormalized(neg, prec), tol) {
+				t.Errorf("BigSincNormalized(%g) != BigSincNormalized(%g)", v, -v)
+			}
+		}
+	})
+
+	t.Run("normalized_half", func(t *testing.T) {
+		// sinc(1/2) = sin(π/2)/(π/2) = 2/π
+		want := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(2, prec), BigPI(prec))
+		if got := BigSincNormalized(NewBigFloat(0.5, prec), prec); !BigFloatEqual(got, want, tol) {
+			t.Errorf("BigSincNormalized(0.5) = %s, want 2/π = %s", got.Text('g', 50), want.Text('g', 50))
+		}
+	})
+}
+
+// TestBigAtan2SignedZero pins IEEE 754 signed-zero semantics for y = ±0
+func TestBigAtan2SignedZero(t *testing.T) {
+	prec := uint(256)
+	negZero := math.Copysign(0, -1)
+	pi := BigPI(prec)
+	negPi := new(BigFloat).Neg(pi)
+
+	tests := []struct {
+		name     string
+		y, x     float64
+		expected *BigFloat
+		negative bool
+	}{
+		{"+0,+x", 0, 2, NewBigFloat(0, prec), false},
+		{"-0,+x", negZero, 2, NewBigFloat(0, prec), true},
+		{"+0,-x", 0, -2, pi, false},
+		{"-0,-x", negZero, -2, negPi, true},
+		{"+0,+0", 0, 0, NewBigFloat(0, prec), false},
+		{"-0,+0", negZero, 0, NewBigFloat(0, prec), true},
+		{"+0,-0", 0, negZero, pi, false},
+		{"-0,-0", negZero, negZero, negPi, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			y, x := NewBigFloat(tt.y, prec), NewBigFloat(tt.x, prec)
+			for name, atan2 := range map[string]func(y, x *BigFloat, prec uint) *BigFloat{
+				"BigAtan2":          BigAtan2,
+				"bigAtan2Generic":   bigAtan2Generic,
+				"bigAtan2Optimized": bigAtan2Optimized,
+			} {
+				got := atan2(y, x, prec)
+				if got.Cmp(tt.expected) != 0 || got.Signbit() != tt.negative {
+					t.Errorf("%s(%g, %g) = %s (signbit %v), want %s (signbit %v)",
+						name, tt.y, tt.x, got.Text('g', 20), got.Signbit(), tt.expected.Text('g', 20), tt.negative)
+				}
+			}
+		})
+	}
+}
@@ -5,11 +5,22 @@ package bigmath
 
 import (
 	"math"
+	"math/big"
 )
 
 // Ulp computes the Unit in the Last Place for a BigFloat x.
-// For x = m * 2^e with precision p, ulp(x) = 2^(e-p).
+// For x = m * 2^e with 0.5 <= |m| < 1 and precision p, ulp(x) = 2^(e-p).
+// The exponent is taken from x and p is the requested prec (x.Prec() if 0), so
+// a value stored at 256 bits has a 64-bit ULP of 2^(e-64).
+// Ulp(±Inf) is +Inf. Below the exponent range, where 2^(e-p) is not
+// representable, the ULP saturates at the smallest positive BigFloat.
 func Ulp(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	if x.IsInf() {
+		return new(BigFloat).SetPrec(prec).SetInf(false)
+	}
 	if x.Sign() == 0 {
 		// For zero, ULP is the smallest representable number > 0
 		// which is 2^(MinExp - prec) roughly, but practically 0 for error bounds
@@ -19,10 +30,15 @@ func Ulp(x *BigFloat, prec uint) *BigFloat {
 	// Get exponent
 	exp := x.MantExp(nil)
 
-	ulpExp := exp - int(prec)
+	// 0.5 * 2^MinExp is the smallest positive BigFloat; int64 keeps exp - prec
+	// from wrapping on 32-bit platforms
+	mant, ulpExp := 1.0, int64(exp)-int64(prec)
+	if ulpExp < big.MinExp {
+		mant, ulpExp = 0.5, big.MinExp
+	}
 
 	res := new(BigFloat).SetPrec(prec)
-	res.SetMantExp(new(BigFloat).SetFloat64(1.0), ulpExp)
+	res.SetMantExp(new(BigFloat).SetFloat64(mant), int(ulpExp))
 
 	return res
 }
@@ -142,6 +158,47 @@ func PropagateErrorMul(x, y, z *BigFloat, errX, errY ErrorBound, prec uint, mode
 	return ErrorBound{Value: totalUlps, IsUlp: true}
 }
 
+// PropagateErrorSub propagates error for subtraction z = x - y
+// Error(z) <= Error(x) + Error(y) + RoundingError, as for addition. The bound is
+// absolute, so when x ≈ y and |z| is small it spans many ULPs of z: the relative
+// error is amplified by roughly (|x| + |y|)/|z| (catastrophic cancellation).
+func PropagateErrorSub(x, y, z *BigFloat, errX, errY ErrorBound, prec uint, mode RoundingMode) ErrorBound {
+	return PropagateErrorAdd(x, y, z, errX, errY, prec, mode)
+}
+
+// PropagateErrorDiv propagates error for division z = x / y
+// Quotient rule: Error(z) <= (Error(x) + |z|*Error(y)) / (|y| - Error(y)) + RoundingError
+// The bound is infinite when the error in y can reach zero.
+func PropagateErrorDiv(x, y, z *BigFloat, errX, errY ErrorBound, prec uint, mode RoundingMode) ErrorBound {
+	absErrX := errX.ToAbs(x, prec)
+	absErrX.Abs(absErrX)
+	absErrY := errY.ToAbs(y, prec)
+	absErrY.Abs(absErrY)
+
+	// Denominator |y| - Error(y) keeps the bound valid for the worst y in range
+	denom := new(BigFloat).SetPrec(prec).Abs(y)
+	denom.Sub(denom, absErrY)
+	if denom.Sign() <= 0 || z.IsInf() {
+		return NewAbsError(new(BigFloat).SetInf(false), prec)
+	}
+
+	totalAbsErr := new(BigFloat).SetPrec(prec).Abs(z)
+	totalAbsErr.Mul(totalAbsErr, absErrY)
+	totalAbsErr.Add(totalAbsErr, absErrX)
+	totalAbsErr.Quo(totalAbsErr, denom)
+
+	// Add rounding error
+	roundingErrUlps := 0.5
+	if mode != ToNearest {
+		roundingErrUlps = 1.0
+	}
+
+	roundingErr := NewUlpError(roundingErrUlps, prec).ToAbs(z, prec)
+	totalAbsErr.Add(totalAbsErr, roundingErr)
+
+	return NewAbsError(totalAbsErr, prec)
+}
+
 // CalculateRequiredPrecision estimates the working precision needed to achieve
 // target precision with given error bounds.
 // Rule of thumb: WorkingPrec = TargetPrec + log2(AccumulatedErrorUlps) + GuardBits
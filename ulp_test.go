@@ -5,6 +5,7 @@ package bigmath
 
 import (
 	"math"
+	"math/big"
 	"testing"
 )
 
@@ -98,6 +99,54 @@ func TestUlp(t *testing.T) {
 	})
 }
 
+// TestUlpEdgeCases pins Ulp for Inf, mixed precisions and the exponent limit
+func TestUlpEdgeCases(t *testing.T) {
+	t.Run("inf", func(t *testing.T) {
+		for _, neg := range []bool{false, true} {
+			ulp := Ulp(new(BigFloat).SetInf(neg), 128)
+			if !ulp.IsInf() || ulp.Sign() < 0 {
+				t.Errorf("Ulp(Inf, neg=%v) = %s, want +Inf", neg, ulp.Text('g', 10))
+			}
+		}
+	})
+
+	t.Run("requested_precision", func(t *testing.T) {
+		// 3 = 0.75 * 2^2, stored at 256 bits
+		x := NewBigFloat(3.0, 256)
+		for _, prec := range []uint{53, 64, 256, 512} {
+			want := new(BigFloat).SetMantExp(big.NewFloat(1), 2-int(prec))
+			if got := Ulp(x, prec); got.Cmp(want) != 0 {
+				t.Errorf("Ulp(3 @256, %d) = %s, want 2^%d", prec, got.Text('g', 10), 2-int(prec))
+			}
+		}
+	})
+
+	t.Run("default_precision", func(t *testing.T) {
+		x := NewBigFloat(-0.375, 100) // -0.75 * 2^-1
+		want := new(BigFloat).SetMantExp(big.NewFloat(1), -1-100)
+		if got := Ulp(x, 0); got.Cmp(want) != 0 {
+			t.Errorf("Ulp(-0.375 @100, 0) = %s, want 2^-101", got.Text('g', 10))
+		}
+	})
+
+	t.Run("sign_independent", func(t *testing.T) {
+		x := NewBigFloat(1e-10, 128)
+		neg := new(BigFloat).Neg(x)
+		if Ulp(x, 128).Cmp(Ulp(neg, 128)) != 0 || Ulp(neg, 128).Sign() <= 0 {
+			t.Error("Ulp(-x) should equal Ulp(x) and be positive")
+		}
+	})
+
+	t.Run("exponent_limit", func(t *testing.T) {
+		tiny := new(BigFloat).SetPrec(256).SetMantExp(big.NewFloat(0.5), big.MinExp)
+		ulp := Ulp(tiny, 256)
+		smallest := new(BigFloat).SetMantExp(big.NewFloat(0.5), big.MinExp)
+		if ulp.Cmp(smallest) != 0 {
+			t.Errorf("Ulp near MinExp = %s, want smallest positive BigFloat", ulp.Text('g', 10))
+		}
+	})
+}
+
 // TestNewUlpError tests ULP error creation
 func TestNewUlpError(t *testing.T) {
 	prec := uint(256)
@@ -293,6 +342,88 @@ func TestPropagateErrorMul(t *testing.T) {
 	}
 }
 
+// TestPropagateErrorSub tests that the subtraction bound grows with cancellation
+func TestPropagateErrorSub(t *testing.T) {
+	prec := uint(256)
+	y := NewBigFloat(1.0, prec)
+	errX := NewUlpError(1.0, prec)
+	errY := NewUlpError(1.0, prec)
+
+	prevUlps := new(BigFloat)
+	for _, gap := range []int{-10, -50, -150} {
+		x := new(BigFloat).SetPrec(prec).SetMantExp(NewBigFloat(1.0, prec), gap)
+		x.Add(x, y)
+		z := new(BigFloat).SetPrec(prec).Sub(x, y)
+
+		result := PropagateErrorSub(x, y, z, errX, errY, prec, ToNearest)
+		if result.IsUlp {
+			t.Fatal("PropagateErrorSub should return absolute error")
+		}
+
+		// Measured in ULPs of z, the bound grows as x and y get closer
+		ulps := new(BigFloat).SetPrec(prec).Quo(result.Value, Ulp(z, prec))
+		if ulps.Cmp(prevUlps) <= 0 {
+			t.Errorf("gap 2^%d: bound = %s ulp(z), want more than %s", gap, ulps.Text('g', 10), prevUlps.Text('g', 10))
+		}
+		prevUlps = ulps
+
+		// The bound covers the worst-case perturbation of the inputs
+		xp := new(BigFloat).SetPrec(2*prec).Add(x, errX.ToAbs(x, prec))
+		yp := new(BigFloat).SetPrec(2*prec).Sub(y, errY.ToAbs(y, prec))
+		actual := new(BigFloat).SetPrec(2*prec).Sub(xp, yp)
+		actual.Sub(actual, z)
+		if actual.Abs(actual).Cmp(result.Value) > 0 {
+			t.Errorf("gap 2^%d: actual error %s exceeds bound %s", gap, actual.Text('g', 10), result.Value.Text('g', 10))
+		}
+	}
+
+	// 2^-150 cancels about 150 bits, so the bound spans ~2^150 ulp(z)
+	if prevUlps.MantExp(nil) < 140 {
+		t.Errorf("bound after cancelling 150 bits = %s ulp(z), want ~2^150", prevUlps.Text('g', 10))
+	}
+}
+
+// TestPropagateErrorDiv tests error propagation for division
+func TestPropagateErrorDiv(t *testing.T) {
+	prec := uint(256)
+
+	x := NewBigFloat(1.0, prec)
+	y := NewBigFloat(3.0, prec)
+	z := new(BigFloat).SetPrec(prec).Quo(x, y)
+
+	errX := NewUlpError(1.0, prec)
+	errY := NewUlpError(1.0, prec)
+
+	result := PropagateErrorDiv(x, y, z, errX, errY, prec, ToNearest)
+	if result.IsUlp {
+		t.Fatal("PropagateErrorDiv should return absolute error")
+	}
+
+	// Relative errors of about 2^-256 each: a few ULPs of z
+	ulps, _ := new(BigFloat).Quo(result.Value, Ulp(z, prec)).Float64()
+	if ulps < 0.5 || ulps > 10 {
+		t.Errorf("Error = %v ULPs of z, seems unreasonable", ulps)
+	}
+
+	// The bound covers the worst-case perturbation of the inputs
+	xp := new(BigFloat).SetPrec(2*prec).Add(x, errX.ToAbs(x, prec))
+	yp := new(BigFloat).SetPrec(2*prec).Sub(y, errY.ToAbs(y, prec))
+	actual := new(BigFloat).SetPrec(2*prec).Quo(xp, yp)
+	actual.Sub(actual, z)
+	if actual.Abs(actual).Cmp(result.Value) > 0 {
+		t.Errorf("actual error %s exceeds bound %s", actual.Text('g', 10), result.Value.Text('g', 10))
+	}
+
+	t.Run("divisor_error_reaches_zero", func(t *testing.T) {
+		tiny := NewBigFloat(1e-30, prec)
+		q := new(BigFloat).SetPrec(prec).Quo(x, tiny)
+		result := PropagateErrorDiv(x, tiny, q, errX, NewAbsError(NewBigFloat(1e-29, prec), prec), prec, ToNearest)
+		if !result.Value.IsInf() {
+			t.Errorf("Error = %s, want +Inf", result.Value.Text('g', 10))
+		}
+	})
+}
+
 // TestCalculateRequiredPrecision tests precision calculation
 func TestCalculateRequiredPrecision(t *testing.T) {
 	tests := []struct {
@@ -402,3 +533,37 @@ func TestErrorPropagationChain(t *testing.T) {
 		t.Errorf("Error bound should be non-negative, got %v", errWFloat)
 	}
 }
+
+// TestErrorPropagationChainSubDiv chains subtraction and division with Add/Mul
+func TestErrorPropagationChainSubDiv(t *testing.T) {
+	prec := uint(256)
+
+	// w = (x + y) * 2
+	x := NewBigFloat(1.0, prec)
+	y := NewBigFloat(2.0, prec)
+	z := new(BigFloat).SetPrec(prec).Add(x, y)
+	errZ := PropagateErrorAdd(x, y, z, NewUlpError(0.5, prec), NewUlpError(0.5, prec), prec, ToNearest)
+
+	two := NewBigFloat(2.0, prec)
+	w := new(BigFloat).SetPrec(prec).Mul(z, two)
+	errW := PropagateErrorMul(z, two, w, errZ, NewUlpError(0.0, prec), prec, ToNearest)
+
+	// d = w - 5.9, then q = d / y
+	c, _ := NewBigFloatFromString("5.9", prec)
+	d := new(BigFloat).SetPrec(prec).Sub(w, c)
+	errD := PropagateErrorSub(w, c, d, errW, NewUlpError(0.5, prec), prec, ToNearest)
+
+	q := new(BigFloat).SetPrec(prec).Quo(d, y)
+	errQ := PropagateErrorDiv(d, y, q, errD, NewUlpError(0.5, prec), prec, ToNearest)
+
+	if errQ.Value == nil || errQ.Value.IsInf() || errQ.Value.Sign() <= 0 {
+		t.Fatalf("Chained error bound = %v, want finite and positive", errQ.Value)
+	}
+
+	// Cancellation in d (6 - 5.9) should leave q with many more ULPs of error
+	// than the inputs carried
+	ulps, _ := new(BigFloat).Quo(errQ.Value, Ulp(q, prec)).Float64()
+	if ulps < 10 {
+		t.Errorf("Chained error = %v ULPs of q, expected growth from cancellation", ulps)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+// Defined unit values used by ephemeris conversions
+// The IAU 2012 Resolution B2 fixes the astronomical unit at exactly
+// 149597870700 m, and a day is exactly 86400 SI seconds.
+const (
+	auInKMStr     = "149597870.7"
+	secondsPerDay = 86400.0
+	auInMeters    = 149597870700.0
+)
+
+// BigAUinKM returns the astronomical unit in kilometres (149597870.7) with specified precision
+func BigAUinKM(prec uint) *BigFloat {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+	result, err := NewBigFloatFromString(auInKMStr, prec)
+	if err != nil {
+		panic("BigAUinKM: invalid constant string: " + err.Error())
+	}
+	return result
+}
+
+// BigAUinMeters returns the astronomical unit in metres (149597870700) with specified precision
+func BigAUinMeters(prec uint) *BigFloat {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+	return NewBigFloat(auInMeters, prec)
+}
+
+// BigSecondsPerDay returns the number of SI seconds in a day (86400) with specified precision
+func BigSecondsPerDay(prec uint) *BigFloat {
+	if prec == 0 {
+		prec = DefaultPrecision
+	}
+	return NewBigFloat(secondsPerDay, prec)
+}
+
+// AUtoKM converts a distance in astronomical units to kilometres
+func AUtoKM(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	workPrec := prec + 32
+	result := new(BigFloat).SetPrec(workPrec).Mul(x, BigAUinKM(workPrec))
+	return new(BigFloat).SetPrec(prec).Set(result)
+}
+
+// KMtoAU converts a distance in kilometres to astronomical units
+func KMtoAU(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	workPrec := prec + 32
+	result := new(BigFloat).SetPrec(workPrec).Quo(x, BigAUinKM(workPrec))
+	return new(BigFloat).SetPrec(prec).Set(result)
+}
+
+// DaysToSeconds converts a duration in days to SI seconds
+func DaysToSeconds(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	return new(BigFloat).SetPrec(prec).Mul(x, BigSecondsPerDay(prec))
+}
+
+// SecondsToDays converts a duration in SI seconds to days
+func SecondsToDays(x *BigFloat, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = x.Prec()
+	}
+	return new(BigFloat).SetPrec(prec).Quo(x, BigSecondsPerDay(prec))
+}
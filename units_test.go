@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"testing"
+)
+
+func TestUnitConstants(t *testing.T) {
+	prec := uint(256)
+
+	// 1 AU = 149597870700 m exactly, so 1000 * AU[km] must match to full precision
+	auMeters := new(BigFloat).SetPrec(prec).Mul(BigAUinKM(prec), NewBigFloat(1000, prec))
+	diff := new(BigFloat).SetPrec(prec).Sub(auMeters, BigAUinMeters(prec))
+	bound := new(BigFloat).SetMantExp(BigAUinMeters(prec), -int(prec)+2)
+	if diff.Abs(diff).Cmp(bound) > 0 {
+		t.Errorf("1000 * BigAUinKM = %s, want 149597870700", auMeters.Text('f', 60))
+	}
+
+	if got := BigAUinKM(prec).Text('f', 1); got != "149597870.7" {
+		t.Errorf("BigAUinKM = %s, want 149597870.7", got)
+	}
+	if !BigEqualFloat64(BigAUinMeters(prec), 149597870700) {
+		t.Errorf("BigAUinMeters = %s, want 149597870700", BigAUinMeters(prec).Text('f', 1))
+	}
+	if !BigEqualFloat64(BigSecondsPerDay(prec), 86400) {
+		t.Errorf("BigSecondsPerDay = %s, want 86400", BigSecondsPerDay(prec).Text('f', 1))
+	}
+}
+
+func TestUnitConversions(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-55", prec)
+
+	t.Run("au_km_round_trip", func(t *testing.T) {
+		for _, s := range []string{"1", "0.38709927", "30.06992276", "-5.2", "1e-12"} {
+			x, _ := NewBigFloatFromString(s, prec)
+			back := KMtoAU(AUtoKM(x, prec), prec)
+			diff := new(BigFloat).SetPrec(prec).Sub(back, x)
+			if diff.Abs(diff).Cmp(tolerance) > 0 {
+				t.Errorf("KMtoAU(AUtoKM(%s)) differs by %s", s, diff.Text('g', 10))
+			}
+		}
+	})
+
+	t.Run("one_au", func(t *testing.T) {
+		if got := AUtoKM(NewBigFloat(1, prec), prec); got.Cmp(BigAUinKM(prec)) != 0 {
+			t.Errorf("AUtoKM(1) = %s, want %s", got.Text('f', 30), BigAUinKM(prec).Text('f', 30))
+		}
+	})
+
+	t.Run("one_day", func(t *testing.T) {
+		if got := DaysToSeconds(NewBigFloat(1, prec), prec); !BigEqualFloat64(got, 86400) {
+			t.Errorf("DaysToSeconds(1) = %s, want exactly 86400", got.Text('f', 30))
+		}
+		if got := SecondsToDays(NewBigFloat(43200, prec), prec); !BigEqualFloat64(got, 0.5) {
+			t.Errorf("SecondsToDays(43200) = %s, want exactly 0.5", got.Text('f', 30))
+		}
+	})
+
+	t.Run("julian_century", func(t *testing.T) {
+		got := DaysToSeconds(BigJulianCentury(prec), prec)
+		if !BigEqualFloat64(got, 36525*86400) {
+			t.Errorf("DaysToSeconds(36525) = %s, want %d", got.Text('f', 1), 36525*86400)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+// BigVec4 represents a 4D vector with arbitrary precision, typically the
+// homogeneous coordinates (X, Y, Z, W) of a 3D point
+type BigVec4 struct {
+	X, Y, Z, W *BigFloat
+}
+
+// NewBigVec4 creates a new BigVec4 from float64 values
+func NewBigVec4(x, y, z, w float64, prec uint) *BigVec4 {
+	return &BigVec4{
+		X: NewBigFloat(x, prec),
+		Y: NewBigFloat(y, prec),
+		Z: NewBigFloat(z, prec),
+		W: NewBigFloat(w, prec),
+	}
+}
+
+// Copy creates a deep copy of a BigVec4
+func (v *BigVec4) Copy() *BigVec4 {
+	prec := v.X.Prec()
+	return &BigVec4{
+		X: new(BigFloat).SetPrec(prec).Set(v.X),
+		Y: new(BigFloat).SetPrec(prec).Set(v.Y),
+		Z: new(BigFloat).SetPrec(prec).Set(v.Z),
+		W: new(BigFloat).SetPrec(prec).Set(v.W),
+	}
+}
+
+// ToFloat64 converts BigVec4 to float64 array
+func (v *BigVec4) ToFloat64() [4]float64 {
+	x, _ := v.X.Float64()
+	y, _ := v.Y.Float64()
+	z, _ := v.Z.Float64()
+	w, _ := v.W.Float64()
+	return [4]float64{x, y, z, w}
+}
+
+// ToVec3 performs the perspective divide (X/W, Y/W, Z/W)
+// A point at infinity (W = 0) returns the zero vector.
+func (v *BigVec4) ToVec3(prec uint) *BigVec3 {
+	if prec == 0 {
+		prec = v.X.Prec()
+	}
+	if v.W.Sign() == 0 {
+		return NewBigVec3(0, 0, 0, prec)
+	}
+	return &BigVec3{
+		X: new(BigFloat).SetPrec(prec).Quo(v.X, v.W),
+		Y: new(BigFloat).SetPrec(prec).Quo(v.Y, v.W),
+		Z: new(BigFloat).SetPrec(prec).Quo(v.Z, v.W),
+	}
+}
+
+// BigVec4Add adds two BigVec4 vectors: result = v1 + v2
+func BigVec4Add(v1, v2 *BigVec4, prec uint) *BigVec4 {
+	if prec == 0 {
+		prec = v1.X.Prec()
+	}
+	return &BigVec4{
+		X: new(BigFloat).SetPrec(prec).Add(v1.X, v2.X),
+		Y: new(BigFloat).SetPrec(prec).Add(v1.Y, v2.Y),
+		Z: new(BigFloat).SetPrec(prec).Add(v1.Z, v2.Z),
+		W: new(BigFloat).SetPrec(prec).Add(v1.W, v2.W),
+	}
+}
+
+// BigVec4Sub subtracts two BigVec4 vectors: result = v1 - v2
+func BigVec4Sub(v1, v2 *BigVec4, prec uint) *BigVec4 {
+	if prec == 0 {
+		prec = v1.X.Prec()
+	}
+	return &BigVec4{
+		X: new(BigFloat).SetPrec(prec).Sub(v1.X, v2.X),
+		Y: new(BigFloat).SetPrec(prec).Sub(v1.Y, v2.Y),
+		Z: new(BigFloat).SetPrec(prec).Sub(v1.Z, v2.Z),
+		W: new(BigFloat).SetPrec(prec).Sub(v1.W, v2.W),
+	}
+}
+
+// BigVec4Mul multiplies a BigVec4 by a scalar: result = v * scalar
+func BigVec4Mul(v *BigVec4, scalar *BigFloat, prec uint) *BigVec4 {
+	if prec == 0 {
+		prec = v.X.Prec()
+	}
+	return &BigVec4{
+		X: new(BigFloat).SetPrec(prec).Mul(v.X, scalar),
+		Y: new(BigFloat).SetPrec(prec).Mul(v.Y, scalar),
+		Z: new(BigFloat).SetPrec(prec).Mul(v.Z, scalar),
+		W: new(BigFloat).SetPrec(prec).Mul(v.W, scalar),
+	}
+}
+
+// BigVec4Dot computes the dot product of two BigVec4 vectors
+func BigVec4Dot(v1, v2 *BigVec4, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = v1.X.Prec()
+	}
+	return BigFloatDotProduct(
+		[]*BigFloat{v1.X, v1.Y, v1.Z, v1.W},
+		[]*BigFloat{v2.X, v2.Y, v2.Z, v2.W},
+		prec,
+	)
+}
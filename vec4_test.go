@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Mohammad Shafiee
+// SPDX-License-Identifier: BSD-3-Clause
+
+package bigmath
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBigVec4Ops(t *testing.T) {
+	prec := uint(256)
+	v1 := NewBigVec4(1.0, 2.0, 3.0, 4.0, prec)
+	v2 := NewBigVec4(0.5, -1.0, 2.5, -2.0, prec)
+
+	tests := []struct {
+		name     string
+		got      *BigVec4
+		expected [4]float64
+	}{
+		{"add", BigVec4Add(v1, v2, prec), [4]float64{1.5, 1.0, 5.5, 2.0}},
+		{"sub", BigVec4Sub(v1, v2, prec), [4]float64{0.5, 3.0, 0.5, 6.0}},
+		{"mul", BigVec4Mul(v1, NewBigFloat(-0.5, prec), prec), [4]float64{-0.5, -1.0, -1.5, -2.0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.ToFloat64(); got != tt.expected {
+				t.Errorf("got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("dot", func(t *testing.T) {
+		// 0.5 - 2 + 7.5 - 8
+		if got := BigVec4Dot(v1, v2, prec); got.Cmp(NewBigFloat(-2.0, prec)) != 0 {
+			t.Errorf("BigVec4Dot = %s, want -2", got.Text('g', 20))
+		}
+	})
+
+	t.Run("copy", func(t *testing.T) {
+		c := v1.Copy()
+		c.W.SetFloat64(99)
+		if w, _ := v1.W.Float64(); w != 4.0 {
+			t.Error("Modifying copy affected original")
+		}
+	})
+
+	// Test precision levels
+	t.Run("precision_levels", func(t *testing.T) {
+		testCases := []uint{64, 128, 256, 512}
+		for _, p := range testCases {
+			a := NewBigVec4(1.0, 0.0, 0.0, 1.0, p)
+			b := NewBigVec4(0.0, 1.0, 0.0, 1.0, p)
+			got := BigVec4Add(a, b, p)
+			if got.X.Prec() != p {
+				t.Errorf("BigVec4Add at prec %d returned prec %d", p, got.X.Prec())
+			}
+			if dot, _ := BigVec4Dot(a, b, p).Float64(); math.Abs(dot-1.0) > 1e-6 {
+				t.Errorf("BigVec4Dot at prec %d = %g, want 1", p, dot)
+			}
+		}
+	})
+}
+
+func TestBigVec4ToVec3(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-70", prec)
+
+	t.Run("perspective_divide", func(t *testing.T) {
+		got := NewBigVec4(2.0, -4.0, 6.0, 4.0, prec).ToVec3(prec)
+		if !BigVec3Equal(got, NewBigVec3(0.5, -1.0, 1.5, prec), tol) {
+			t.Errorf("ToVec3 = %v, want (0.5, -1, 1.5)", got.ToFloat64())
+		}
+	})
+
+	t.Run("inexact_divide", func(t *testing.T) {
+		got := NewBigVec4(1.0, 2.0, 3.0, 3.0, prec).ToVec3(prec)
+		third := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1, prec), NewBigFloat(3, prec))
+		if got.X.Cmp(third) != 0 {
+			t.Errorf("ToVec3 X = %s, want 1/3", got.X.Text('g', 40))
+		}
+	})
+
+	t.Run("point_at_infinity", func(t *testing.T) {
+		got := NewBigVec4(1.0, 2.0, 3.0, 0.0, prec).ToVec3(prec)
+		if got.X.Sign() != 0 || got.Y.Sign() != 0 || got.Z.Sign() != 0 {
+			t.Errorf("ToVec3 with W=0 = %v, want zero vector", got.ToFloat64())
+		}
+	})
+}
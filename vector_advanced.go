@@ -3,12 +3,27 @@
 
 package bigmath
 
+import "fmt"
+
 // BigVec3Cross computes the cross product of two 3D vectors: v1 × v2
 // Result = (v1.Y*v2.Z - v1.Z*v2.Y, v1.Z*v2.X - v1.X*v2.Z, v1.X*v2.Y - v1.Y*v2.X)
 func BigVec3Cross(v1, v2 *BigVec3, prec uint) *BigVec3 {
 	return getDispatcher().BigVec3CrossImpl(v1, v2, prec)
 }
 
+// BigVec3ScalarTriple computes the scalar triple product a · (b × c)
+// This is the signed volume of the parallelepiped spanned by a, b and c,
+// and equals the determinant of the matrix with rows a, b, c.
+func BigVec3ScalarTriple(a, b, c *BigVec3, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = a.X.Prec()
+	}
+
+	workPrec := prec + 32
+	res := BigVec3Dot(a, BigVec3Cross(b, c, workPrec), workPrec)
+	return new(BigFloat).SetPrec(prec).Set(res)
+}
+
 // BigVec3Normalize normalizes a 3D vector to unit length
 // Returns a unit vector in the same direction, or zero vector if input is zero
 func BigVec3Normalize(v *BigVec3, prec uint) *BigVec3 {
@@ -26,3 +41,144 @@ func BigVec3Angle(v1, v2 *BigVec3, prec uint) *BigFloat {
 func BigVec3Project(v1, v2 *BigVec3, prec uint) *BigVec3 {
 	return getDispatcher().BigVec3ProjectImpl(v1, v2, prec)
 }
+
+// BigVec3Reflect reflects v across the plane with the given normal
+// Returns v - 2(v·n̂)n̂, where n̂ is normal scaled to unit length.
+// A zero normal returns a copy of v.
+func BigVec3Reflect(v, normal *BigVec3, prec uint) *BigVec3 {
+	if prec == 0 {
+		prec = v.X.Prec()
+	}
+
+	if normal.X.Sign() == 0 && normal.Y.Sign() == 0 && normal.Z.Sign() == 0 {
+		return roundBigVec3(v, prec)
+	}
+
+	workPrec := prec + 32
+	n := BigVec3Normalize(normal, workPrec)
+	twoDot := BigVec3Dot(v, n, workPrec)
+	twoDot.Add(twoDot, twoDot)
+	return roundBigVec3(BigVec3Sub(v, BigVec3Mul(n, twoDot, workPrec), workPrec), prec)
+}
+
+// OrthonormalizeBig returns an orthonormal basis for vectors using modified
+// Gram-Schmidt: each vector has its projections onto the already accepted
+// basis vectors removed one at a time and is then normalized
+// Returns an error if a vector has no component left, that is, it is zero or
+// linearly dependent on the earlier ones (relative to 2^(16-prec) of its length).
+func OrthonormalizeBig(vectors []*BigVec3, prec uint) ([]*BigVec3, error) {
+	if len(vectors) == 0 {
+		return nil, nil
+	}
+	if prec == 0 {
+		prec = vectors[0].X.Prec()
+	}
+
+	workPrec := prec + 32
+	basis := make([]*BigVec3, 0, len(vectors))
+	for i, v := range vectors {
+		w := roundBigVec3(v, workPrec)
+		for _, q := range basis {
+			w = BigVec3Sub(w, BigVec3Mul(q, BigVec3Dot(w, q, workPrec), workPrec), workPrec)
+		}
+
+		threshold := BigVec3Magnitude(v, workPrec)
+		threshold.SetMantExp(threshold, 16-int(prec))
+		norm := BigVec3Magnitude(w, workPrec)
+		if norm.Sign() == 0 || norm.Cmp(threshold) <= 0 {
+			return nil, fmt.Errorf("vector %d is zero or linearly dependent on the previous vectors", i)
+		}
+
+		inv := new(BigFloat).SetPrec(workPrec).Quo(NewBigFloat(1.0, workPrec), norm)
+		basis = append(basis, BigVec3Mul(w, inv, workPrec))
+	}
+
+	for i, q := range basis {
+		basis[i] = roundBigVec3(q, prec)
+	}
+	return basis, nil
+}
+
+// BigVec3Abs returns the component-wise absolute value of a 3D vector
+func BigVec3Abs(v *BigVec3, prec uint) *BigVec3 {
+	if prec == 0 {
+		prec = v.X.Prec()
+	}
+
+	return &BigVec3{
+		X: BigAbs(v.X, prec),
+		Y: BigAbs(v.Y, prec),
+		Z: BigAbs(v.Z, prec),
+	}
+}
+
+// BigVec3Sign returns the signs of the components of a 3D vector as -1, 0 or +1
+// Both +0 and -0 report 0; use Signbit on the component to tell them apart
+func BigVec3Sign(v *BigVec3) [3]int {
+	return [3]int{v.X.Sign(), v.Y.Sign(), v.Z.Sign()}
+}
+
+// BigClampVec3 limits each component of v to the range given by the matching
+// components of lo and hi, following BigClamp (lo wins when lo > hi)
+func BigClampVec3(v, lo, hi *BigVec3, prec uint) *BigVec3 {
+	if prec == 0 {
+		prec = v.X.Prec()
+	}
+
+	return &BigVec3{
+		X: BigClamp(v.X, lo.X, hi.X, prec),
+		Y: BigClamp(v.Y, lo.Y, hi.Y, prec),
+		Z: BigClamp(v.Z, lo.Z, hi.Z, prec),
+	}
+}
+
+// BigVec6Abs returns the component-wise absolute value of a BigVec6
+func BigVec6Abs(v *BigVec6, prec uint) *BigVec6 {
+	if prec == 0 {
+		prec = v.X.Prec()
+	}
+
+	return &BigVec6{
+		X:  BigAbs(v.X, prec),
+		Y:  BigAbs(v.Y, prec),
+		Z:  BigAbs(v.Z, prec),
+		VX: BigAbs(v.VX, prec),
+		VY: BigAbs(v.VY, prec),
+		VZ: BigAbs(v.VZ, prec),
+	}
+}
+
+// BigVec6Sign returns the signs of the components of a BigVec6 as -1, 0 or +1
+// in X, Y, Z, VX, VY, VZ order. Both +0 and -0 report 0.
+func BigVec6Sign(v *BigVec6) [6]int {
+	return [6]int{v.X.Sign(), v.Y.Sign(), v.Z.Sign(), v.VX.Sign(), v.VY.Sign(), v.VZ.Sign()}
+}
+
+// BigTriangleArea computes the area of the triangle with vertices a, b, c
+// Area = ½|(b−a)×(c−a)|
+func BigTriangleArea(a, b, c *BigVec3, prec uint) *BigFloat {
+	if prec == 0 {
+		prec = a.X.Prec()
+	}
+
+	workPrec := prec + 32
+	cross := BigVec3Cross(BigVec3Sub(b, a, workPrec), BigVec3Sub(c, a, workPrec), workPrec)
+	area := BigVec3Magnitude(cross, workPrec)
+	area.Quo(area, NewBigFloat(2.0, workPrec))
+
+	return new(BigFloat).SetPrec(prec).Set(area)
+}
+
+// BigTriangleNormal computes the unit normal of the triangle with vertices a, b, c
+// The normal follows the right-hand rule for the order a → b → c.
+// Degenerate (collinear) triangles return the NaN sentinel, which is the zero vector.
+func BigTriangleNormal(a, b, c *BigVec3, prec uint) *BigVec3 {
+	if prec == 0 {
+		prec = a.X.Prec()
+	}
+
+	workPrec := prec + 32
+	cross := BigVec3Cross(BigVec3Sub(b, a, workPrec), BigVec3Sub(c, a, workPrec), workPrec)
+
+	return BigVec3Normalize(cross, prec)
+}
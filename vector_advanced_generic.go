@@ -31,12 +31,39 @@ func bigVec3CrossGeneric(v1, v2 *BigVec3, prec uint) *BigVec3 {
 	}
 }
 
+// scaleBigVec3ByMaxExponent returns a copy of v multiplied by 2^-e, where e is the
+// largest binary exponent among the components. The scaling is exact and brings
+// the largest component into [0.5, 1), so squaring cannot overflow or underflow
+// and the float64 initial guess used by BigSqrt stays finite.
+func scaleBigVec3ByMaxExponent(v *BigVec3) *BigVec3 {
+	maxExp := 0
+	found := false
+	for _, c := range [3]*BigFloat{v.X, v.Y, v.Z} {
+		if c.Sign() == 0 {
+			continue
+		}
+		if e := c.MantExp(nil); !found || e > maxExp {
+			maxExp = e
+			found = true
+		}
+	}
+
+	return &BigVec3{
+		X: new(BigFloat).SetMantExp(v.X, -maxExp),
+		Y: new(BigFloat).SetMantExp(v.Y, -maxExp),
+		Z: new(BigFloat).SetMantExp(v.Z, -maxExp),
+	}
+}
+
 // bigVec3NormalizeGeneric normalizes a 3D vector using pure Go implementation
+// The vector is first scaled by its largest component so that components near
+// the exponent limits normalize without overflow
 func bigVec3NormalizeGeneric(v *BigVec3, prec uint) *BigVec3 {
 	if prec == 0 {
 		prec = v.X.Prec()
 	}
 
+	v = scaleBigVec3ByMaxExponent(v)
 	magnitude := BigVec3Magnitude(v, prec)
 
 	// Check if magnitude is zero
@@ -85,6 +85,64 @@ func TestBigVec3Cross(t *testing.T) {
 	})
 }
 
+func TestBigVec3ScalarTriple(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	ex := NewBigVec3(1, 0, 0, prec)
+	ey := NewBigVec3(0, 1, 0, prec)
+	ez := NewBigVec3(0, 0, 1, prec)
+
+	tests := []struct {
+		name     string
+		a, b, c  *BigVec3
+		expected float64
+	}{
+		{"right_handed_basis", ex, ey, ez, 1},
+		{"left_handed_basis", ex, ez, ey, -1},
+		{"coplanar", NewBigVec3(1, 2, 0, prec), NewBigVec3(-3, 0.5, 0, prec), NewBigVec3(7, 1, 0, prec), 0},
+		{"repeated_vector", NewBigVec3(1, 2, 3, prec), NewBigVec3(4, 5, 6, prec), NewBigVec3(1, 2, 3, prec), 0},
+		{"scaled_box", NewBigVec3(2, 0, 0, prec), NewBigVec3(0, 3, 0, prec), NewBigVec3(0, 0, 4, prec), 24},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BigVec3ScalarTriple(tt.a, tt.b, tt.c, prec)
+			if got.Cmp(NewBigFloat(tt.expected, prec)) != 0 {
+				t.Errorf("BigVec3ScalarTriple = %s, want %g", got.Text('g', 20), tt.expected)
+			}
+		})
+	}
+
+	t.Run("determinant_and_cyclic", func(t *testing.T) {
+		a := NewBigVec3(1.25, -3, 0.5, prec)
+		b := NewBigVec3(2, 7.5, -1, prec)
+		c := NewBigVec3(-0.75, 4, 9, prec)
+		third := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1, prec), NewBigFloat(3, prec))
+		a.Y.Add(a.Y, third)
+
+		triple := BigVec3ScalarTriple(a, b, c, prec)
+		m := &BigMatrix3x3{M: [3][3]*BigFloat{{a.X, a.Y, a.Z}, {b.X, b.Y, b.Z}, {c.X, c.Y, c.Z}}}
+		for name, other := range map[string]*BigFloat{
+			"det":   BigMatDet(m, prec),
+			"b,c,a": BigVec3ScalarTriple(b, c, a, prec),
+			"c,a,b": BigVec3ScalarTriple(c, a, b, prec),
+		} {
+			diff := new(BigFloat).SetPrec(prec).Sub(triple, other)
+			if diff.Abs(diff).Cmp(tol) > 0 {
+				t.Errorf("a·(b×c) = %s, %s = %s", triple.Text('g', 40), name, other.Text('g', 40))
+			}
+		}
+
+		// Swapping two vectors flips the sign
+		swapped := BigVec3ScalarTriple(b, a, c, prec)
+		swapped.Add(swapped, triple)
+		if swapped.Abs(swapped).Cmp(tol) > 0 {
+			t.Errorf("b·(a×c) != -a·(b×c)")
+		}
+	})
+}
+
 func TestBigVec3Normalize(t *testing.T) {
 	prec := uint(256)
 
@@ -294,3 +352,327 @@ func TestBigVec3Project(t *testing.T) {
 		}
 	})
 }
+
+func TestBigVec3Reflect(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-60", prec)
+
+	tests := []struct {
+		name     string
+		v        *BigVec3
+		normal   *BigVec3
+		expected *BigVec3
+	}{
+		{"across_y_plane", NewBigVec3(1, -1, 0, prec), NewBigVec3(0, 1, 0, prec), NewBigVec3(1, 1, 0, prec)},
+		{"unnormalized_normal", NewBigVec3(1, -1, 0, prec), NewBigVec3(0, -5, 0, prec), NewBigVec3(1, 1, 0, prec)},
+		{"in_plane", NewBigVec3(3, 0, 4, prec), NewBigVec3(0, 2, 0, prec), NewBigVec3(3, 0, 4, prec)},
+		{"diagonal_normal", NewBigVec3(1, 0, 0, prec), NewBigVec3(1, 1, 0, prec), NewBigVec3(0, -1, 0, prec)},
+		{"zero_normal", NewBigVec3(1, 2, 3, prec), NewBigVec3(0, 0, 0, prec), NewBigVec3(1, 2, 3, prec)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BigVec3Reflect(tt.v, tt.normal, prec)
+			if !BigVec3Equal(got, tt.expected, tol) {
+				t.Errorf("BigVec3Reflect = %v, want %v", got.ToFloat64(), tt.expected.ToFloat64())
+			}
+
+			// Reflection preserves length
+			diff := new(BigFloat).SetPrec(prec).Sub(BigVec3Magnitude(got, prec), BigVec3Magnitude(tt.v, prec))
+			if diff.Abs(diff).Cmp(tol) > 0 {
+				t.Errorf("|reflected| differs from |v| by %s", diff.Text('g', 10))
+			}
+		})
+	}
+
+	v := NewBigVec3(1, 2, 3, prec)
+	if got := BigVec3Reflect(v, NewBigVec3(0, 0, 0, prec), prec); got == v || got.X == v.X {
+		t.Error("BigVec3Reflect with a zero normal must return a copy")
+	}
+}
+
+func TestBigVec3AbsSign(t *testing.T) {
+	prec := uint(256)
+
+	v := NewBigVec3(-1.0, 2.0, -3.0, prec)
+	abs := BigVec3Abs(v, 0)
+	if got := abs.ToFloat64(); got != [3]float64{1.0, 2.0, 3.0} {
+		t.Errorf("BigVec3Abs = %v, want [1 2 3]", got)
+	}
+	if abs.X.Prec() != prec || abs.Y.Prec() != prec || abs.Z.Prec() != prec {
+		t.Error("BigVec3Abs did not preserve precision")
+	}
+	if v.X.Sign() >= 0 {
+		t.Error("BigVec3Abs modified its input")
+	}
+
+	sign := BigVec3Sign(NewBigVec3(-1.0, 0.0, 3.0, prec))
+	if sign != [3]int{-1, 0, 1} {
+		t.Errorf("BigVec3Sign = %v, want [-1 0 1]", sign)
+	}
+
+	// Negative zero reports 0 but keeps its sign bit
+	negZero := &BigVec3{X: new(BigFloat).Neg(NewBigFloat(0.0, prec)), Y: NewBigFloat(0.0, prec), Z: NewBigFloat(-2.0, prec)}
+	if sign := BigVec3Sign(negZero); sign != [3]int{0, 0, -1} {
+		t.Errorf("BigVec3Sign(-0, 0, -2) = %v, want [0 0 -1]", sign)
+	}
+	if !negZero.X.Signbit() {
+		t.Error("negative zero lost its sign bit")
+	}
+}
+
+func TestBigClampVec3(t *testing.T) {
+	prec := uint(128)
+
+	lo := NewBigVec3(-1.0, 0.0, 5.0, 64)
+	hi := NewBigVec3(1.0, 10.0, 3.0, 64) // Z range is inverted
+	v := NewBigVec3(-2.0, 10.0, 4.0, 64)
+
+	result := BigClampVec3(v, lo, hi, prec)
+	if got := result.ToFloat64(); got != [3]float64{-1.0, 10.0, 5.0} {
+		t.Errorf("BigClampVec3 = %v, want [-1 10 5]", got)
+	}
+	if result.X.Prec() != prec || result.Y.Prec() != prec || result.Z.Prec() != prec {
+		t.Errorf("BigClampVec3 precision = (%d, %d, %d), want %d", result.X.Prec(), result.Y.Prec(), result.Z.Prec(), prec)
+	}
+	if v.X.Cmp(NewBigFloat(-2.0, prec)) != 0 {
+		t.Error("BigClampVec3 modified its input")
+	}
+
+	inside := NewBigVec3(0.5, 0.0, 5.0, 64)
+	if got := BigClampVec3(inside, lo, hi, 0).ToFloat64(); got != [3]float64{0.5, 0.0, 5.0} {
+		t.Errorf("BigClampVec3 inside range = %v, want [0.5 0 5]", got)
+	}
+}
+
+func TestBigVec6AbsSign(t *testing.T) {
+	prec := uint(128)
+
+	v := NewBigVec6(-1.0, 2.0, -3.0, 0.0, -0.5, 6.0, prec)
+	abs := BigVec6Abs(v, 0)
+	if got := abs.ToFloat64(); got != [6]float64{1.0, 2.0, 3.0, 0.0, 0.5, 6.0} {
+		t.Errorf("BigVec6Abs = %v, want [1 2 3 0 0.5 6]", got)
+	}
+	if abs.VZ.Prec() != prec {
+		t.Errorf("BigVec6Abs precision = %d, want %d", abs.VZ.Prec(), prec)
+	}
+
+	if sign := BigVec6Sign(v); sign != [6]int{-1, 1, -1, 0, -1, 1} {
+		t.Errorf("BigVec6Sign = %v, want [-1 1 -1 0 -1 1]", sign)
+	}
+
+	// Explicit precision is honored
+	if abs := BigVec6Abs(v, 64); abs.X.Prec() != 64 {
+		t.Errorf("BigVec6Abs(prec=64) precision = %d", abs.X.Prec())
+	}
+}
+
+// TestBigVec3NormalizeExtremeMagnitudes tests normalization of vectors whose
+// squared components leave the float64 range
+func TestBigVec3NormalizeExtremeMagnitudes(t *testing.T) {
+	prec := uint(256)
+	parse := func(s string) *BigFloat {
+		x, err := NewBigFloatFromString(s, prec)
+		if err != nil {
+			t.Fatalf("NewBigFloatFromString(%q): %v", s, err)
+		}
+		return x
+	}
+
+	vectors := map[string]*BigVec3{
+		"1e200":     {X: parse("1e200"), Y: parse("3e199"), Z: parse("-2e200")},
+		"1e400":     {X: parse("1e400"), Y: parse("-1e399"), Z: parse("0")},
+		"1e-400":    {X: parse("3e-400"), Y: parse("4e-400"), Z: parse("0")},
+		"mixed_exp": {X: parse("1e300"), Y: parse("1e-300"), Z: parse("-1e300")},
+	}
+
+	impls := map[string]func(*BigVec3, uint) *BigVec3{
+		"dispatch": BigVec3Normalize,
+		"generic":  bigVec3NormalizeGeneric,
+	}
+
+	tolerance := parse("1e-55")
+	one := NewBigFloat(1.0, prec)
+	for vName, v := range vectors {
+		for iName, normalize := range impls {
+			t.Run(vName+"_"+iName, func(t *testing.T) {
+				n := normalize(v, prec)
+				diff := new(BigFloat).SetPrec(prec).Sub(BigVec3Magnitude(n, prec), one)
+				if diff.Abs(diff).Cmp(tolerance) > 0 {
+					t.Errorf("|normalize(v)| - 1 = %s, want within 1e-55", diff.Text('g', 10))
+				}
+				// Direction is preserved: signs match the input
+				if BigVec3Sign(n) != BigVec3Sign(v) {
+					t.Errorf("normalize changed component signs: %v -> %v", BigVec3Sign(v), BigVec3Sign(n))
+				}
+			})
+		}
+	}
+
+	t.Run("pythagorean_1e200", func(t *testing.T) {
+		v := &BigVec3{X: parse("3e200"), Y: parse("4e200"), Z: parse("0")}
+		n := BigVec3Normalize(v, prec)
+		for i, want := range []string{"0.6", "0.8", "0"} {
+			got := [3]*BigFloat{n.X, n.Y, n.Z}[i]
+			diff := new(BigFloat).SetPrec(prec).Sub(got, parse(want))
+			if diff.Abs(diff).Cmp(tolerance) > 0 {
+				t.Errorf("component %d = %s, want %s", i, got.Text('g', 30), want)
+			}
+		}
+	})
+}
+
+func TestBigTriangleAreaAndNormal(t *testing.T) {
+	prec := uint(256)
+	tolerance, _ := NewBigFloatFromString("1e-55", prec)
+
+	within := func(got *BigFloat, want float64) bool {
+		diff := new(BigFloat).SetPrec(prec).Sub(got, NewBigFloat(want, prec))
+		return diff.Abs(diff).Cmp(tolerance) <= 0
+	}
+
+	tests := []struct {
+		name       string
+		a, b, c    *BigVec3
+		wantArea   float64
+		wantNormal [3]float64
+	}{
+		{
+			name:       "unit_right_triangle",
+			a:          NewBigVec3(0, 0, 0, prec),
+			b:          NewBigVec3(1, 0, 0, prec),
+			c:          NewBigVec3(0, 1, 0, prec),
+			wantArea:   0.5,
+			wantNormal: [3]float64{0, 0, 1},
+		},
+		{
+			name:       "reversed_winding",
+			a:          NewBigVec3(0, 0, 0, prec),
+			b:          NewBigVec3(0, 1, 0, prec),
+			c:          NewBigVec3(1, 0, 0, prec),
+			wantArea:   0.5,
+			wantNormal: [3]float64{0, 0, -1},
+		},
+		{
+			name:       "offset_xz_plane",
+			a:          NewBigVec3(1, 2, 3, prec),
+			b:          NewBigVec3(3, 2, 3, prec),
+			c:          NewBigVec3(1, 2, 7, prec),
+			wantArea:   4,
+			wantNormal: [3]float64{0, -1, 0},
+		},
+		{
+			name:       "degenerate_collinear",
+			a:          NewBigVec3(0, 0, 0, prec),
+			b:          NewBigVec3(1, 1, 1, prec),
+			c:          NewBigVec3(2, 2, 2, prec),
+			wantArea:   0,
+			wantNormal: [3]float64{0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			area := BigTriangleArea(tt.a, tt.b, tt.c, prec)
+			if !within(area, tt.wantArea) {
+				t.Errorf("BigTriangleArea = %s, want %g", area.Text('g', 30), tt.wantArea)
+			}
+
+			n := BigTriangleNormal(tt.a, tt.b, tt.c, prec)
+			for i, got := range []*BigFloat{n.X, n.Y, n.Z} {
+				if !within(got, tt.wantNormal[i]) {
+					t.Errorf("BigTriangleNormal component %d = %s, want %g", i, got.Text('g', 30), tt.wantNormal[i])
+				}
+			}
+		})
+	}
+}
+
+func TestOrthonormalizeBig(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+	one := NewBigFloat(1.0, prec)
+
+	checkOrthonormal := func(t *testing.T, basis []*BigVec3) {
+		for i := range basis {
+			for j := i; j < len(basis); j++ {
+				dot := BigVec3Dot(basis[i], basis[j], prec)
+				want := NewBigFloat(0.0, prec)
+				if i == j {
+					want = one
+				}
+				if !BigFloatEqual(dot, want, tol) {
+					t.Errorf("q%d·q%d = %s, want %s", i, j, dot.Text('g', 30), want.Text('g', 5))
+				}
+			}
+		}
+	}
+
+	t.Run("nearly_axis_aligned", func(t *testing.T) {
+		eps, _ := NewBigFloatFromString("1e-20", prec)
+		v := func(x, y, z float64) *BigVec3 {
+			return &BigVec3{
+				X: new(BigFloat).SetPrec(prec).Add(NewBigFloat(x, prec), eps),
+				Y: new(BigFloat).SetPrec(prec).Sub(NewBigFloat(y, prec), eps),
+				Z: new(BigFloat).SetPrec(prec).Add(NewBigFloat(z, prec), eps),
+			}
+		}
+		in := []*BigVec3{v(1, 0, 0), v(0, 1, 0), v(0, 0, 1)}
+
+		basis, err := OrthonormalizeBig(in, prec)
+		if err != nil {
+			t.Fatalf("OrthonormalizeBig: %v", err)
+		}
+		checkOrthonormal(t, basis)
+
+		// The first vector keeps its direction and the triad stays right-handed
+		if !BigVec3Equal(basis[0], BigVec3Normalize(in[0], prec), tol) {
+			t.Errorf("q0 = %v, want normalized input", basis[0].ToFloat64())
+		}
+		if det := BigVec3ScalarTriple(basis[0], basis[1], basis[2], prec); !BigFloatEqual(det, one, tol) {
+			t.Errorf("q0·(q1×q2) = %s, want 1", det.Text('g', 30))
+		}
+	})
+
+	t.Run("nearly_parallel", func(t *testing.T) {
+		in := []*BigVec3{
+			NewBigVec3(1, 1e-8, 0, prec),
+			NewBigVec3(1, 2e-8, 1e-9, prec),
+			NewBigVec3(1, 0, 3e-8, prec),
+		}
+		basis, err := OrthonormalizeBig(in, prec)
+		if err != nil {
+			t.Fatalf("OrthonormalizeBig: %v", err)
+		}
+		checkOrthonormal(t, basis)
+	})
+
+	t.Run("two_vectors", func(t *testing.T) {
+		basis, err := OrthonormalizeBig([]*BigVec3{NewBigVec3(3, 4, 0, prec), NewBigVec3(1, 0, 0, prec)}, prec)
+		if err != nil {
+			t.Fatalf("OrthonormalizeBig: %v", err)
+		}
+		checkOrthonormal(t, basis)
+		x, _ := NewBigFloatFromString("0.8", prec)
+		y, _ := NewBigFloatFromString("-0.6", prec)
+		if !BigVec3Equal(basis[1], &BigVec3{X: x, Y: y, Z: NewBigFloat(0, prec)}, tol) {
+			t.Errorf("q1 = %v, want (0.8, -0.6, 0)", basis[1].ToFloat64())
+		}
+	})
+
+	errorCases := []struct {
+		name string
+		in   []*BigVec3
+	}{
+		{"zero_vector", []*BigVec3{NewBigVec3(1, 0, 0, prec), NewBigVec3(0, 0, 0, prec)}},
+		{"dependent", []*BigVec3{NewBigVec3(1, 2, 3, prec), NewBigVec3(0, 1, 0, prec), NewBigVec3(2, 5, 6, prec)}},
+		{"four_in_3d", []*BigVec3{NewBigVec3(1, 0, 0, prec), NewBigVec3(0, 1, 0, prec), NewBigVec3(0, 0, 1, prec), NewBigVec3(1, 1, 1, prec)}},
+	}
+	for _, tt := range errorCases {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := OrthonormalizeBig(tt.in, prec); err == nil {
+				t.Error("OrthonormalizeBig should return an error for linearly dependent input")
+			}
+		})
+	}
+}
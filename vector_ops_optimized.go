@@ -43,6 +43,9 @@ func bigVec3NormalizeOptimized(v *BigVec3, prec uint) *BigVec3 {
 		prec = v.X.Prec()
 	}
 
+	// Scale by the largest component first to avoid overflow in the sum of squares
+	v = scaleBigVec3ByMaxExponent(v)
+
 	// Calculate magnitude once
 	magSq := new(BigFloat).SetPrec(prec)
 	temp := new(BigFloat).SetPrec(prec)
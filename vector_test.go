@@ -5,6 +5,7 @@ package bigmath
 
 import (
 	"math"
+	"math/big"
 	"testing"
 )
 
@@ -86,6 +87,51 @@ func TestBigVec3Copy(t *testing.T) {
 	}
 }
 
+// TestToFloat32 tests float32 conversion and its exactness reporting
+func TestToFloat32(t *testing.T) {
+	prec := uint(256)
+
+	tests := []struct {
+		name  string
+		input float64
+		want  float32
+		exact bool
+	}{
+		{"half", 0.5, 0.5, true},
+		{"dyadic", -3.375, -3.375, true},
+		{"zero", 0, 0, true},
+		{"tenth", 0.1, 0.1, false},
+		{"third", 1.0 / 3.0, 1.0 / 3.0, false},
+		{"overflow", 1e300, float32(math.Inf(1)), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, acc := BigFloatToFloat32(NewBigFloat(tt.input, prec))
+			if got != tt.want {
+				t.Errorf("BigFloatToFloat32(%g) = %v, want %v", tt.input, got, tt.want)
+			}
+			if (acc == big.Exact) != tt.exact {
+				t.Errorf("BigFloatToFloat32(%g) accuracy = %v, want exact=%v", tt.input, acc, tt.exact)
+			}
+		})
+	}
+
+	t.Run("vec3_exact", func(t *testing.T) {
+		got, exact := NewBigVec3(0.5, -2, 1024.25, prec).ToFloat32()
+		if got != [3]float32{0.5, -2, 1024.25} || !exact {
+			t.Errorf("ToFloat32 = (%v, %v), want ([0.5 -2 1024.25], true)", got, exact)
+		}
+	})
+
+	t.Run("vec3_inexact", func(t *testing.T) {
+		got, exact := NewBigVec3(0.5, 0.1, 2, prec).ToFloat32()
+		if got != [3]float32{0.5, 0.1, 2} || exact {
+			t.Errorf("ToFloat32 = (%v, %v), want ([0.5 0.1 2], false)", got, exact)
+		}
+	})
+}
+
 // TestBigVec6Add tests BigVec6 addition
 func TestBigVec6Add(t *testing.T) {
 	prec := uint(256)
@@ -153,6 +199,56 @@ func TestBigVec6Magnitude(t *testing.T) {
 	}
 }
 
+func TestBigVec6DotMul(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+
+	t.Run("dot_of_position_only_vector", func(t *testing.T) {
+		v := NewBigVec6(1.5, -2.25, 3.125, 0, 0, 0, prec)
+		mag := BigVec6Magnitude(v, prec)
+		want := new(BigFloat).SetPrec(prec).Mul(mag, mag)
+		diff := new(BigFloat).SetPrec(prec).Sub(BigVec6Dot(v, v, prec), want)
+		if diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("BigVec6Dot(v, v) = %s, want |v|² = %s", BigVec6Dot(v, v, prec).Text('g', 40), want.Text('g', 40))
+		}
+	})
+
+	t.Run("dot_includes_velocity", func(t *testing.T) {
+		a := NewBigVec6(1, 2, 3, 4, 5, 6, prec)
+		b := NewBigVec6(-1, 0.5, 2, 0.25, -2, 1, prec)
+		if got := BigVec6Dot(a, b, prec); got.Cmp(NewBigFloat(-1+1+6+1-10+6, prec)) != 0 {
+			t.Errorf("BigVec6Dot = %s, want 3", got.Text('g', 20))
+		}
+
+		// v·v = |r|² + |v|²
+		r := BigVec6Magnitude(a, prec)
+		vel := BigVec3Magnitude(NewBigVec3(4, 5, 6, prec), prec)
+		want := new(BigFloat).SetPrec(prec).Mul(r, r)
+		want.Add(want, new(BigFloat).SetPrec(prec).Mul(vel, vel))
+		diff := new(BigFloat).SetPrec(prec).Sub(BigVec6Dot(a, a, prec), want)
+		if diff.Abs(diff).Cmp(tol) > 0 {
+			t.Errorf("BigVec6Dot(a, a) = %s, want 91", BigVec6Dot(a, a, prec).Text('g', 40))
+		}
+	})
+
+	t.Run("scalar_multiply", func(t *testing.T) {
+		v := NewBigVec6(1, -2, 3.5, -4, 5.25, -6, prec)
+		got := BigVec6Mul(v, NewBigFloat(2.0, prec), prec)
+		want := [6]float64{2, -4, 7, -8, 10.5, -12}
+		for i, c := range []*BigFloat{got.X, got.Y, got.Z, got.VX, got.VY, got.VZ} {
+			if c.Cmp(NewBigFloat(want[i], prec)) != 0 {
+				t.Errorf("component %d = %s, want %g", i, c.Text('g', 20), want[i])
+			}
+			if c.Prec() != prec {
+				t.Errorf("component %d precision = %d, want %d", i, c.Prec(), prec)
+			}
+		}
+		if v.X.Cmp(NewBigFloat(1, prec)) != 0 {
+			t.Error("BigVec6Mul modified its input")
+		}
+	})
+}
+
 // TestBigVec6Copy tests BigVec6 copy functionality
 func TestBigVec6Copy(t *testing.T) {
 	prec := uint(256)
@@ -268,6 +364,72 @@ func TestApplyRotationMatrixToBigVec6(t *testing.T) {
 	}
 }
 
+// TestRotateBigVec6 tests state vector rotation with a time-varying matrix
+func TestRotateBigVec6(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+	v := NewBigVec6(1.0, 2.0, 3.0, 0.5, 0.0, -1.0, prec)
+
+	equal := func(a, b *BigVec6) bool {
+		return BigVec3Equal(&BigVec3{X: a.X, Y: a.Y, Z: a.Z}, &BigVec3{X: b.X, Y: b.Y, Z: b.Z}, tol) &&
+			BigVec3Equal(&BigVec3{X: a.VX, Y: a.VY, Z: a.VZ}, &BigVec3{X: b.VX, Y: b.VY, Z: b.VZ}, tol)
+	}
+
+	t.Run("constant_rotation", func(t *testing.T) {
+		m := CreateRotationMatrixAxisAngle(NewBigVec3(1, -2, 0.5, prec), NewBigFloat(0.8, prec), prec)
+		want := ApplyRotationMatrixToBigVec6(m, v, prec)
+		zero := &BigMatrix3x3{}
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				zero.M[i][j] = NewBigFloat(0, prec)
+			}
+		}
+
+		for _, dm := range []*BigMatrix3x3{nil, zero} {
+			if got := RotateBigVec6(m, dm, v, prec); !equal(got, want) {
+				t.Errorf("RotateBigVec6(m, %v, v) = %v, want %v", dm, got.ToFloat64(), want.ToFloat64())
+			}
+		}
+	})
+
+	t.Run("rotating_frame", func(t *testing.T) {
+		// Body frame turning about z at rate ω: M = Rz(θ), Ṁ = ω·dRz/dθ
+		omega := NewBigFloat(0.25, prec)
+		s, c := BigSinCos(NewBigFloat(1.3, prec), prec)
+		zero, one := NewBigFloat(0, prec), NewBigFloat(1, prec)
+
+		// lin returns a·x + b·y
+		lin := func(a float64, x *BigFloat, b float64, y *BigFloat) *BigFloat {
+			r := new(BigFloat).SetPrec(prec).Mul(NewBigFloat(a, prec), x)
+			return r.Add(r, new(BigFloat).SetPrec(prec).Mul(NewBigFloat(b, prec), y))
+		}
+		mulOmega := func(x *BigFloat) *BigFloat { return new(BigFloat).SetPrec(prec).Mul(omega, x) }
+
+		m := &BigMatrix3x3{M: [3][3]*BigFloat{
+			{c, new(BigFloat).Neg(s), zero},
+			{s, c, zero},
+			{zero, zero, one},
+		}}
+		dm := &BigMatrix3x3{M: [3][3]*BigFloat{
+			{mulOmega(lin(-1, s, 0, c)), mulOmega(lin(0, s, -1, c)), zero},
+			{mulOmega(c), mulOmega(lin(-1, s, 0, c)), zero},
+			{zero, zero, zero},
+		}}
+
+		// r = (c - 2s, s + 2c, 3) and ṙ = (0.5c - ω(s + 2c), 0.5s + ω(c - 2s), -1)
+		vx := lin(0.5, c, -1, mulOmega(lin(1, s, 2, c)))
+		vy := lin(0.5, s, 1, mulOmega(lin(1, c, -2, s)))
+		want := &BigVec6{
+			X: lin(1, c, -2, s), Y: lin(1, s, 2, c), Z: NewBigFloat(3, prec),
+			VX: vx, VY: vy, VZ: NewBigFloat(-1, prec),
+		}
+
+		if got := RotateBigVec6(m, dm, v, prec); !equal(got, want) {
+			t.Errorf("RotateBigVec6 = %v, want %v", got.ToFloat64(), want.ToFloat64())
+		}
+	})
+}
+
 // TestCreateRotationMatrix tests rotation matrix creation
 func TestCreateRotationMatrix(t *testing.T) {
 	prec := uint(256)
@@ -296,8 +458,56 @@ func TestCreateRotationMatrix(t *testing.T) {
 		t.Error("CreateRotationMatrix with π/2 returned nil")
 	}
 
-	// Verify it's a valid rotation matrix (orthogonal)
-	// For now just check it's not nil and has proper structure
+	// Verify both are proper rotations
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+	for i, rot := range []*BigMatrix3x3{m, m2} {
+		if err := ValidateRotationMatrix(rot, tol, prec); err != nil {
+			t.Errorf("CreateRotationMatrix case %d: %v", i, err)
+		}
+	}
+}
+
+func TestCreateRotationMatrixAxisAngle(t *testing.T) {
+	prec := uint(256)
+	tol, _ := NewBigFloatFromString("1e-40", prec)
+
+	t.Run("quarter_turn_about_z", func(t *testing.T) {
+		// The axis does not need to be normalized
+		m := CreateRotationMatrixAxisAngle(NewBigVec3(0, 0, 2.5, prec), BigHalfPI(prec), prec)
+		got := BigMatMul(m, NewBigVec3(1, 2, 3, prec), prec)
+		if !BigVec3Equal(got, NewBigVec3(-2, 1, 3, prec), tol) {
+			t.Errorf("90° about z of (1,2,3) = %v, want (-2,1,3)", got.ToFloat64())
+		}
+		if m.M[1][0].Prec() != prec {
+			t.Errorf("precision = %d, want %d", m.M[1][0].Prec(), prec)
+		}
+	})
+
+	t.Run("oblique_axis", func(t *testing.T) {
+		// A third of a turn about (1,1,1) cycles the coordinate axes
+		third := BigTwoPI(prec)
+		third.Quo(third, NewBigFloat(3, prec))
+		m := CreateRotationMatrixAxisAngle(NewBigVec3(1, 1, 1, prec), third, prec)
+		got := BigMatMul(m, NewBigVec3(1, 0, 0, prec), prec)
+		if !BigVec3Equal(got, NewBigVec3(0, 1, 0, prec), tol) {
+			t.Errorf("120° about (1,1,1) of (1,0,0) = %v, want (0,1,0)", got.ToFloat64())
+		}
+		if !BigMatIsOrthogonal(m, tol, prec) {
+			t.Error("axis-angle rotation matrix is not orthogonal")
+		}
+	})
+
+	t.Run("zero_axis", func(t *testing.T) {
+		m := CreateRotationMatrixAxisAngle(NewBigVec3(0, 0, 0, prec), NewBigFloat(1.0, prec), prec)
+		id := NewIdentityMatrix(prec)
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				if m.M[i][j].Cmp(id.M[i][j]) != 0 {
+					t.Errorf("zero axis [%d][%d] = %s, want identity", i, j, m.M[i][j].Text('g', 10))
+				}
+			}
+		}
+	})
 }
 
 // TestBigMaxMin tests BigMax and BigMin functions
@@ -363,6 +573,104 @@ func TestBigMaxMin(t *testing.T) {
 	})
 }
 
+func TestBigFloatEqual(t *testing.T) {
+	prec := uint(256)
+
+	a, _ := NewBigFloatFromString("1.000000000000000000000000000001", prec)
+	b, _ := NewBigFloatFromString("1.000000000000000000000000000002", prec)
+	tight, _ := NewBigFloatFromString("1e-31", prec)
+	loose, _ := NewBigFloatFromString("1e-29", prec)
+
+	// The values differ at the 30th decimal digit, invisible to float64
+	af, _ := a.Float64()
+	bf, _ := b.Float64()
+	if af != bf {
+		t.Fatalf("test values should be equal as float64")
+	}
+
+	if BigFloatEqual(a, b, tight) {
+		t.Error("BigFloatEqual with tol 1e-31 must see the difference at the 30th digit")
+	}
+	if !BigFloatEqual(a, b, loose) {
+		t.Error("BigFloatEqual with tol 1e-29 must accept the values")
+	}
+	if !BigFloatEqual(a, a, NewBigFloat(0, prec)) {
+		t.Error("BigFloatEqual(a, a, 0) must be true")
+	}
+
+	inf := new(BigFloat).SetInf(false)
+	if !BigFloatEqual(inf, new(BigFloat).SetInf(false), tight) || BigFloatEqual(inf, new(BigFloat).SetInf(true), loose) || BigFloatEqual(inf, a, loose) {
+		t.Error("BigFloatEqual must only match infinities of the same sign")
+	}
+
+	va := &BigVec3{X: NewBigFloat(1, prec), Y: a, Z: NewBigFloat(-3, prec)}
+	vb := &BigVec3{X: NewBigFloat(1, prec), Y: b, Z: NewBigFloat(-3, prec)}
+	if BigVec3Equal(va, vb, tight) {
+		t.Error("BigVec3Equal with tol 1e-31 must see the difference at the 30th digit")
+	}
+	if !BigVec3Equal(va, vb, loose) {
+		t.Error("BigVec3Equal with tol 1e-29 must accept the vectors")
+	}
+}
+
+func TestBigClamp(t *testing.T) {
+	prec := uint(256)
+	lo := NewBigFloat(-1.0, 53)
+	hi := NewBigFloat(2.0, 53)
+
+	tests := []struct {
+		name     string
+		x        float64
+		expected float64
+	}{
+		{"below", -5.0, -1.0},
+		{"above", 7.5, 2.0},
+		{"inside", 0.25, 0.25},
+		{"at_lower_bound", -1.0, -1.0},
+		{"at_upper_bound", 2.0, 2.0},
+		{"zero", 0.0, 0.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := BigClamp(NewBigFloat(tt.x, 53), lo, hi, prec)
+			if result.Cmp(NewBigFloat(tt.expected, prec)) != 0 {
+				t.Errorf("BigClamp(%g, -1, 2) = %s, want %g", tt.x, result.Text('g', 20), tt.expected)
+			}
+			if result.Prec() != prec {
+				t.Errorf("BigClamp precision = %d, want %d", result.Prec(), prec)
+			}
+		})
+	}
+
+	t.Run("infinite_input", func(t *testing.T) {
+		if result := BigClamp(new(BigFloat).SetInf(false), lo, hi, prec); result.Cmp(hi) != 0 {
+			t.Errorf("BigClamp(+Inf, -1, 2) = %s, want 2", result.Text('g', 20))
+		}
+		if result := BigClamp(new(BigFloat).SetInf(true), lo, hi, prec); result.Cmp(lo) != 0 {
+			t.Errorf("BigClamp(-Inf, -1, 2) = %s, want -1", result.Text('g', 20))
+		}
+	})
+
+	t.Run("inverted_range_returns_lo", func(t *testing.T) {
+		for _, x := range []float64{-5.0, 0.0, 5.0} {
+			result := BigClamp(NewBigFloat(x, prec), hi, lo, prec)
+			if result.Cmp(hi) != 0 {
+				t.Errorf("BigClamp(%g, 2, -1) = %s, want 2", x, result.Text('g', 20))
+			}
+		}
+	})
+
+	t.Run("returns_copy", func(t *testing.T) {
+		x := NewBigFloat(0.5, prec)
+		result := BigClamp(x, lo, hi, prec)
+		result.SetFloat64(9.0)
+		if x.Cmp(NewBigFloat(0.5, prec)) != 0 {
+			t.Error("BigClamp result aliases its input")
+		}
+	})
+}
+
 // TestBigFloatFMA tests Fused Multiply-Add
 func TestBigFloatFMA(t *testing.T) {
 	prec := uint(256)
@@ -425,3 +733,129 @@ func TestBigFloatDotProduct(t *testing.T) {
 		t.Error("BigFloatDotProduct with prec=0 returned nil")
 	}
 }
+
+// TestBigEqualFloat64 tests exact comparison against float64 values
+func TestBigEqualFloat64(t *testing.T) {
+	prec := uint(256)
+
+	half := NewBigFloat(0.5, prec)
+	if !BigEqualFloat64(half, 0.5) {
+		t.Error("BigEqualFloat64(0.5, 0.5) = false, want true")
+	}
+	if BigCmpFloat64(half, 0.5) != 0 {
+		t.Errorf("BigCmpFloat64(0.5, 0.5) = %d, want 0", BigCmpFloat64(half, 0.5))
+	}
+
+	// 0.5 + 1e-60 rounds to 0.5 in float64 but is a different value
+	nearHalf, _ := NewBigFloatFromString("0.500000000000000000000000000000000000000000000000000000000001", prec)
+	if f, _ := nearHalf.Float64(); f != 0.5 {
+		t.Fatalf("test value should round to 0.5 in float64, got %g", f)
+	}
+	if BigEqualFloat64(nearHalf, 0.5) {
+		t.Error("BigEqualFloat64 should detect a difference in the 60th digit")
+	}
+	if BigCmpFloat64(nearHalf, 0.5) != 1 {
+		t.Errorf("BigCmpFloat64(0.5+1e-60, 0.5) = %d, want 1", BigCmpFloat64(nearHalf, 0.5))
+	}
+
+	// Low-precision x must still compare against the exact float64 value
+	lowPrec := new(BigFloat).SetPrec(8).SetFloat64(1.0)
+	if BigEqualFloat64(lowPrec, 1.0000000000000002) {
+		t.Error("BigEqualFloat64 rounded f to x's precision")
+	}
+	if BigCmpFloat64(lowPrec, 1.0000000000000002) != -1 {
+		t.Error("BigCmpFloat64(1, 1+2^-52) should be -1 regardless of x's precision")
+	}
+
+	posInf := new(BigFloat).SetInf(false)
+	negInf := new(BigFloat).SetInf(true)
+	tests := []struct {
+		name  string
+		x     *BigFloat
+		f     float64
+		cmp   int
+		equal bool
+	}{
+		{"pos_inf_equal", posInf, math.Inf(1), 0, true},
+		{"neg_inf_equal", negInf, math.Inf(-1), 0, true},
+		{"pos_inf_vs_max", posInf, math.MaxFloat64, 1, false},
+		{"neg_inf_vs_pos_inf", negInf, math.Inf(1), -1, false},
+		{"finite_vs_pos_inf", half, math.Inf(1), -1, false},
+		{"finite_vs_neg_inf", half, math.Inf(-1), 1, false},
+		{"negative_zero", NewBigFloat(0.0, prec), math.Copysign(0, -1), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BigCmpFloat64(tt.x, tt.f); got != tt.cmp {
+				t.Errorf("BigCmpFloat64 = %d, want %d", got, tt.cmp)
+			}
+			if got := BigEqualFloat64(tt.x, tt.f); got != tt.equal {
+				t.Errorf("BigEqualFloat64 = %v, want %v", got, tt.equal)
+			}
+		})
+	}
+
+	if BigEqualFloat64(NewBigFloat(0.0, prec), math.NaN()) {
+		t.Error("BigEqualFloat64(x, NaN) = true, want false")
+	}
+}
+
+func TestBigVec3InPlace(t *testing.T) {
+	prec := uint(256)
+	third := new(BigFloat).SetPrec(prec).Quo(NewBigFloat(1.0, prec), NewBigFloat(3.0, prec))
+	a := &BigVec3{X: third, Y: NewBigFloat(-2.5, prec), Z: BigPI(prec)}
+	b := NewBigVec3(1e-30, 7.25, -0.1, prec)
+	s := BigSqrt(NewBigFloat(2.0, prec), prec)
+
+	t.Run("matches_allocating", func(t *testing.T) {
+		cases := []struct {
+			name    string
+			inPlace func(v *BigVec3)
+			want    *BigVec3
+		}{
+			{"add", func(v *BigVec3) { v.AddInPlace(b, prec) }, BigVec3Add(a, b, prec)},
+			{"sub", func(v *BigVec3) { v.SubInPlace(b, prec) }, BigVec3Sub(a, b, prec)},
+			{"mul", func(v *BigVec3) { v.MulScalarInPlace(s, prec) }, BigVec3Mul(a, s, prec)},
+		}
+		for _, tc := range cases {
+			v := a.Copy()
+			x := v.X
+			tc.inPlace(v)
+			if v.X.Cmp(tc.want.X) != 0 || v.Y.Cmp(tc.want.Y) != 0 || v.Z.Cmp(tc.want.Z) != 0 {
+				t.Errorf("%s: in place = %v, allocating = %v", tc.name, v.ToFloat64(), tc.want.ToFloat64())
+			}
+			if v.X != x {
+				t.Errorf("%s: component pointer was replaced instead of updated", tc.name)
+			}
+		}
+	})
+
+	t.Run("receiver_precision", func(t *testing.T) {
+		// A 64-bit receiver rounds to 64 bits whatever prec is passed
+		v := &BigVec3{
+			X: new(BigFloat).SetPrec(64).Set(a.X),
+			Y: new(BigFloat).SetPrec(64).Set(a.Y),
+			Z: new(BigFloat).SetPrec(64).Set(a.Z),
+		}
+		want := BigVec3Add(v, b, 64)
+		v.AddInPlace(b, prec)
+		if v.X.Prec() != 64 || v.X.Cmp(want.X) != 0 || v.Y.Cmp(want.Y) != 0 || v.Z.Cmp(want.Z) != 0 {
+			t.Errorf("AddInPlace on 64-bit receiver = %v (prec %d), want %v at prec 64", v.ToFloat64(), v.X.Prec(), want.ToFloat64())
+		}
+
+		// Zero-value components take prec
+		z := &BigVec3{X: new(BigFloat), Y: new(BigFloat), Z: new(BigFloat)}
+		z.AddInPlace(a, 100)
+		if z.X.Prec() != 100 {
+			t.Errorf("AddInPlace on zero-value component gave prec %d, want 100", z.X.Prec())
+		}
+	})
+
+	t.Run("scalar_is_component", func(t *testing.T) {
+		v := NewBigVec3(2.0, 3.0, 4.0, prec)
+		v.MulScalarInPlace(v.X, prec)
+		if !BigVec3Equal(v, NewBigVec3(4.0, 6.0, 8.0, prec), NewBigFloat(0.0, prec)) {
+			t.Errorf("v.MulScalarInPlace(v.X) = %v, want (4, 6, 8)", v.ToFloat64())
+		}
+	})
+}